@@ -0,0 +1,66 @@
+package cmd
+
+/*
+Copyright © 2024 Ganeshdip Dumbare <ganeshdip.dumbare@gmail.com>
+*/
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"deblock/config"
+	"deblock/internal/migrate"
+
+	goredislib "github.com/redis/go-redis/v9"
+	"github.com/spf13/cobra"
+)
+
+// migrateCmd represents the migrate command
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Upgrade persisted state to the current schema version",
+	Long: `This command checks the schema version of persisted state (Redis)
+and applies any pending migrations so the monitor can start safely.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+			Level: slog.LevelDebug,
+		}))
+
+		config, err := config.LoadConfig()
+		if err != nil {
+			logger.Error("Failed to load configuration", "error", err)
+			os.Exit(1)
+		}
+
+		if err := runStateMigrations(cmd.Context(), logger, redisAddress(config.RedisURL), true); err != nil {
+			logger.Error("State migration failed", "error", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+}
+
+// runStateMigrations checks and upgrades the persisted state schema version
+func runStateMigrations(ctx context.Context, logger *slog.Logger, redisAddr string, autoMigrate bool) error {
+	redisClient := goredislib.NewClient(&goredislib.Options{
+		Addr: redisAddr,
+	})
+	defer redisClient.Close()
+
+	migrator, err := migrate.NewMigrator(logger, migrate.NewRedisVersionStore(redisClient), migrate.Migrations(), autoMigrate)
+	if err != nil {
+		return fmt.Errorf("failed to create state migrator: %w", err)
+	}
+	return migrator.Run(ctx)
+}
+
+// redisAddress strips the redis:// scheme from a Redis URL
+func redisAddress(redisURL string) string {
+	return strings.TrimPrefix(redisURL, "redis://")
+}
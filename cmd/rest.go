@@ -8,16 +8,20 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
-	"strings"
 
 	"deblock/config"
 	"deblock/internal/address"
 	"deblock/internal/api/rest"
 	"deblock/internal/blockchain"
 	"deblock/internal/dlock"
+	"deblock/internal/enrich"
+	"deblock/internal/objectstore"
+	"deblock/internal/pricing"
 	"deblock/internal/pubsub"
 	"deblock/internal/txmonitor"
 
+	"github.com/ethereum/go-ethereum"
+	goredislib "github.com/redis/go-redis/v9"
 	"github.com/spf13/cobra"
 )
 
@@ -77,11 +81,28 @@ It sets up the necessary routes and listens for incoming HTTP requests.`,
 			os.Exit(1)
 		}
 
+		// Load ABIs used to decode method names of contract calls
+		var ethereumOpts []blockchain.EthereumOption
+		if len(config.ABIFiles) > 0 {
+			abiRegistry := blockchain.NewABIRegistry()
+			for _, path := range config.ABIFiles {
+				if err := abiRegistry.RegisterFile(path); err != nil {
+					logger.Error("Failed to load ABI file",
+						"error", err,
+						"path", path,
+					)
+					os.Exit(1)
+				}
+			}
+			ethereumOpts = append(ethereumOpts, blockchain.WithABIRegistry(abiRegistry))
+		}
+
 		// Create blockchain client
 		blockchainClient, err := blockchain.NewEthereumClient(
 			logger,
 			config.EthereumRPCURL,
 			config.EthereumWSURL,
+			ethereumOpts...,
 		)
 		if err != nil {
 			logger.Error("Failed to create blockchain client",
@@ -91,29 +112,68 @@ It sets up the necessary routes and listens for incoming HTTP requests.`,
 			os.Exit(1)
 		}
 
-		// Create address watcher
-		addressWatcher := address.NewInMemoryAddressWatcher()
+		txMonitorOpts := []txmonitor.Option{
+			txmonitor.WithSkipFailedTransactions(config.SkipFailedTransactions),
+		}
 
-		// Add watched addresses to address watcher
-		if len(config.WatchedAddresses) > 0 {
-			logger.Info("Adding watched addresses",
-				"count", len(config.WatchedAddresses),
+		// Reverse-resolve ENS names of counterparties
+		if config.ENS.Enabled {
+			ensEnricher, err := enrich.NewENSEnricher(logger, blockchainClient, config.ENS.CacheSize, config.ENS.CacheTTL)
+			if err != nil {
+				logger.Error("Failed to create ENS enricher", "error", err)
+				os.Exit(1)
+			}
+			txMonitorOpts = append(txMonitorOpts, txmonitor.WithEnrichers(ensEnricher))
+		}
+
+		// Decode ERC-20 transfers and denominate their amounts
+		if config.TokenMetadata.Enabled {
+			tokenEnricher, err := enrich.NewTokenEnricher(logger, blockchainClient, config.TokenMetadata.CacheSize, config.TokenMetadata.CacheTTL)
+			if err != nil {
+				logger.Error("Failed to create token enricher", "error", err)
+				os.Exit(1)
+			}
+			txMonitorOpts = append(txMonitorOpts, txmonitor.WithEnrichers(tokenEnricher))
+		}
+
+		// Value transferred amounts in USD at block time
+		if config.Pricing.Enabled {
+			feed, err := newPriceFeed(config.Pricing, blockchainClient)
+			if err != nil {
+				logger.Error("Failed to create price feed",
+					"error", err,
+					"backend", config.Pricing.Backend,
+				)
+				os.Exit(1)
+			}
+			txMonitorOpts = append(txMonitorOpts, txmonitor.WithEnrichers(enrich.NewFiatEnricher(logger, feed)))
+		}
+
+		// Create watch-only candidate watcher for pre-launch sizing
+		if len(config.CandidateAddresses) > 0 {
+			logger.Info("Enabling watch-only mode for candidate addresses",
+				"count", len(config.CandidateAddresses),
 			)
-			addressWatcher.AddAddresses(cmd.Context(), config.WatchedAddresses)
+			candidateWatcher := address.NewInMemoryAddressWatcher()
+			candidateWatcher.AddAddresses(cmd.Context(), config.CandidateAddresses)
+			txMonitorOpts = append(txMonitorOpts, txmonitor.WithCandidateWatcher(candidateWatcher))
 		}
 
-		// Create distributed lock
-		var redisAddr string
-		if strings.HasPrefix(config.RedisURL, "redis://") {
-			// Remove redis:// prefix
-			redisAddr = strings.TrimPrefix(config.RedisURL, "redis://")
-		} else {
-			redisAddr = config.RedisURL
+		// Verify persisted state is compatible before touching it
+		redisAddr := redisAddress(config.RedisURL)
+		if err := runStateMigrations(cmd.Context(), logger, redisAddr, config.StateAutoMigrate); err != nil {
+			logger.Error("Persisted state is not compatible",
+				"error", err,
+			)
+			os.Exit(1)
 		}
+
+		// Create distributed lock
 		distributedLock := dlock.NewRedsyncLock(redisAddr)
 
 		// Create publisher
-		publisher, err := pubsub.NewKafkaWatermillPublisher(logger, config.KafkaBrokers)
+		var publisher pubsub.Publisher
+		publisher, err = pubsub.NewKafkaWatermillPublisher(logger, config.KafkaBrokers)
 		if err != nil {
 			logger.Error("Failed to create publisher",
 				"error", err,
@@ -122,6 +182,51 @@ It sets up the necessary routes and listens for incoming HTTP requests.`,
 			os.Exit(1)
 		}
 
+		// Guard against oversized payloads by offloading them to object storage
+		if config.PayloadMaxBytes > 0 {
+			store, err := objectstore.NewS3Store(objectstore.S3Config{
+				Endpoint:  config.ObjectStore.Endpoint,
+				Bucket:    config.ObjectStore.Bucket,
+				Region:    config.ObjectStore.Region,
+				AccessKey: config.ObjectStore.AccessKey,
+				SecretKey: config.ObjectStore.SecretKey,
+			}, nil)
+			if err != nil {
+				logger.Error("Failed to create object store",
+					"error", err,
+					"endpoint", config.ObjectStore.Endpoint,
+				)
+				os.Exit(1)
+			}
+			publisher = pubsub.NewOverflowPublisher(logger, publisher, store, config.PayloadMaxBytes)
+		}
+
+		// Persist watch list history so past coverage can be queried by block
+		redisClient := goredislib.NewClient(&goredislib.Options{
+			Addr: redisAddr,
+		})
+		watchHistory := address.NewRedisHistoryStore(redisClient)
+
+		// Create address watcher, recording history and publishing changes to the control topic
+		addressWatcher := address.NewPublishingAddressWatcher(
+			logger,
+			address.NewHistoryAddressWatcher(
+				logger,
+				address.NewInMemoryAddressWatcher(),
+				watchHistory,
+				blockchainClient.BlockNumber,
+			),
+			publisher,
+		)
+
+		// Add watched addresses to address watcher
+		if len(config.WatchedAddresses) > 0 {
+			logger.Info("Adding watched addresses",
+				"count", len(config.WatchedAddresses),
+			)
+			addressWatcher.AddAddresses(address.WithActor(cmd.Context(), "config"), config.WatchedAddresses)
+		}
+
 		// Create transaction monitor service
 		txMonitorService := txmonitor.NewTxMonitorService(
 			logger,
@@ -129,10 +234,14 @@ It sets up the necessary routes and listens for incoming HTTP requests.`,
 			addressWatcher,
 			publisher,
 			distributedLock,
+			txMonitorOpts...,
 		)
 
 		// Create a new rest api instance
-		api, err := rest.NewApi(logger, config.ServerPort, txMonitorService)
+		api, err := rest.NewApi(logger, config.ServerPort, txMonitorService,
+			rest.WithWatchHistory(watchHistory),
+			rest.WithTransactionLookup(blockchainClient, config.TransactionLookupMaxHashes),
+		)
 		if err != nil {
 			logger.Error("Failed to create new rest api",
 				"error", err,
@@ -146,6 +255,14 @@ It sets up the necessary routes and listens for incoming HTTP requests.`,
 	},
 }
 
+// newPriceFeed creates the configured pricing backend
+func newPriceFeed(cfg config.PricingConfig, caller ethereum.ContractCaller) (pricing.Feed, error) {
+	if cfg.Backend == "coingecko" {
+		return pricing.NewCoinGeckoFeed(cfg.CoinGeckoURL, cfg.CoinGeckoAPIKey, cfg.CoinGeckoCoinID, nil)
+	}
+	return pricing.NewChainlinkFeed(caller, cfg.ChainlinkFeed)
+}
+
 func init() {
 	rootCmd.AddCommand(restCmd)
 }
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/spf13/viper"
@@ -18,6 +19,56 @@ type Config struct {
 	RedisURL         string   `validate:"required,url"`
 	KafkaBrokers     []string `validate:"required"`
 	WatchedAddresses []string `validate:"required"`
+	// CandidateAddresses are counted in watch-only mode but never published
+	CandidateAddresses []string
+	// PayloadMaxBytes offloads larger events to object storage (0 disables the guard)
+	PayloadMaxBytes int `validate:"min=0"`
+	ObjectStore     ObjectStoreConfig
+	// SkipFailedTransactions drops reverted transactions instead of publishing them
+	SkipFailedTransactions bool
+	// ABIFiles are JSON ABI files used to decode method names of contract calls
+	ABIFiles []string
+	// StateAutoMigrate upgrades persisted state on startup instead of refusing to start
+	StateAutoMigrate bool
+	ENS              ENSConfig
+	TokenMetadata    TokenMetadataConfig
+	Pricing          PricingConfig
+	// TransactionLookupMaxHashes bounds the hashes accepted by one lookup request
+	TransactionLookupMaxHashes int `validate:"min=1"`
+}
+
+// ENSConfig controls reverse ENS enrichment of published transactions
+type ENSConfig struct {
+	Enabled   bool
+	CacheSize int           `validate:"min=0"`
+	CacheTTL  time.Duration `validate:"min=0"`
+}
+
+// TokenMetadataConfig controls ERC-20 transfer decoding and denomination of published transactions
+type TokenMetadataConfig struct {
+	Enabled   bool
+	CacheSize int           `validate:"min=0"`
+	CacheTTL  time.Duration `validate:"min=0"`
+}
+
+// PricingConfig controls USD valuation of published transactions
+type PricingConfig struct {
+	Enabled bool
+	Backend string `validate:"oneof=chainlink coingecko"`
+	// ChainlinkFeed is the aggregator proxy address read at the block of each transaction
+	ChainlinkFeed   string
+	CoinGeckoURL    string `validate:"omitempty,url"`
+	CoinGeckoAPIKey string
+	CoinGeckoCoinID string
+}
+
+// ObjectStoreConfig holds S3-compatible object storage settings for oversized payloads
+type ObjectStoreConfig struct {
+	Endpoint  string `validate:"omitempty,url"`
+	Bucket    string `validate:"required_with=Endpoint"`
+	Region    string
+	AccessKey string
+	SecretKey string
 }
 
 // Validate performs structural validation on the configuration
@@ -28,6 +79,10 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	if c.PayloadMaxBytes > 0 && c.ObjectStore.Endpoint == "" {
+		return fmt.Errorf("invalid configuration: object store endpoint is required when payload max bytes is set")
+	}
+
 	return nil
 }
 
@@ -49,6 +104,45 @@ func LoadConfig() (*Config, error) {
 	// Watched addresses default (empty list)
 	v.SetDefault("watched_addresses", []string{})
 
+	// Watch-only candidate addresses default (empty list disables the mode)
+	v.SetDefault("candidate_addresses", []string{})
+
+	// Reverted transactions are published with a failed status by default
+	v.SetDefault("skip_failed_transactions", false)
+
+	// No ABIs are registered by default; only the 4-byte selector is published
+	v.SetDefault("abi_files", []string{})
+
+	// Persisted state is upgraded automatically on startup by default
+	v.SetDefault("state_auto_migrate", true)
+
+	// ENS enrichment defaults (disabled)
+	v.SetDefault("ens.enabled", false)
+	v.SetDefault("ens.cache_size", 10000)
+	v.SetDefault("ens.cache_ttl", "1h")
+
+	// Token metadata defaults (disabled)
+	v.SetDefault("token_metadata.enabled", false)
+	v.SetDefault("token_metadata.cache_size", 10000)
+	v.SetDefault("token_metadata.cache_ttl", "24h")
+
+	// Pricing defaults (disabled)
+	v.SetDefault("pricing.enabled", false)
+	v.SetDefault("pricing.backend", "chainlink")
+	v.SetDefault("pricing.chainlink_feed", "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")
+	v.SetDefault("pricing.coingecko_url", "https://api.coingecko.com/api/v3")
+	v.SetDefault("pricing.coingecko_api_key", "")
+	v.SetDefault("pricing.coingecko_coin_id", "ethereum")
+
+	// Transaction lookup defaults
+	v.SetDefault("transaction_lookup_max_hashes", 500)
+
+	// Payload size guard defaults (disabled)
+	v.SetDefault("payload_max_bytes", 0)
+	v.SetDefault("object_store.endpoint", "")
+	v.SetDefault("object_store.bucket", "")
+	v.SetDefault("object_store.region", "us-east-1")
+
 	// Retry configuration defaults
 	v.SetDefault("retry.base_delay", 100)
 	v.SetDefault("retry.max_delay", 5000)
@@ -83,6 +177,29 @@ func LoadConfig() (*Config, error) {
 		{"redis_url", "REDIS_URL"},
 		{"kafka_brokers", "KAFKA_BROKERS"},
 		{"watched_addresses", "WATCHED_ADDRESSES"},
+		{"candidate_addresses", "CANDIDATE_ADDRESSES"},
+		{"skip_failed_transactions", "SKIP_FAILED_TRANSACTIONS"},
+		{"abi_files", "ABI_FILES"},
+		{"state_auto_migrate", "STATE_AUTO_MIGRATE"},
+		{"ens.enabled", "ENS_ENRICHMENT_ENABLED"},
+		{"ens.cache_size", "ENS_CACHE_SIZE"},
+		{"ens.cache_ttl", "ENS_CACHE_TTL"},
+		{"token_metadata.enabled", "TOKEN_METADATA_ENABLED"},
+		{"token_metadata.cache_size", "TOKEN_METADATA_CACHE_SIZE"},
+		{"token_metadata.cache_ttl", "TOKEN_METADATA_CACHE_TTL"},
+		{"pricing.enabled", "PRICING_ENABLED"},
+		{"pricing.backend", "PRICING_BACKEND"},
+		{"pricing.chainlink_feed", "PRICING_CHAINLINK_FEED"},
+		{"pricing.coingecko_url", "PRICING_COINGECKO_URL"},
+		{"pricing.coingecko_api_key", "PRICING_COINGECKO_API_KEY"},
+		{"pricing.coingecko_coin_id", "PRICING_COINGECKO_COIN_ID"},
+		{"transaction_lookup_max_hashes", "TRANSACTION_LOOKUP_MAX_HASHES"},
+		{"payload_max_bytes", "PAYLOAD_MAX_BYTES"},
+		{"object_store.endpoint", "OBJECT_STORE_ENDPOINT"},
+		{"object_store.bucket", "OBJECT_STORE_BUCKET"},
+		{"object_store.region", "OBJECT_STORE_REGION"},
+		{"object_store.access_key", "OBJECT_STORE_ACCESS_KEY"},
+		{"object_store.secret_key", "OBJECT_STORE_SECRET_KEY"},
 		{"retry.base_delay", "RETRY_BASE_DELAY"},
 		{"retry.max_delay", "RETRY_MAX_DELAY"},
 		{"retry.max_retries", "RETRY_MAX_RETRIES"},
@@ -96,14 +213,45 @@ func LoadConfig() (*Config, error) {
 
 	// Prepare configuration
 	config := &Config{
-		ServerPort:       v.GetString("server_port"),
-		LogLevel:         getLogLevel(v.GetString("log_level")),
-		GinMode:          v.GetString("gin_mode"),
-		EthereumRPCURL:   v.GetString("ethereum_rpc_url"),
-		EthereumWSURL:    v.GetString("ethereum_ws_url"),
-		RedisURL:         v.GetString("redis_url"),
-		KafkaBrokers:     v.GetStringSlice("kafka_brokers"),
-		WatchedAddresses: v.GetStringSlice("watched_addresses"),
+		ServerPort:             v.GetString("server_port"),
+		LogLevel:               getLogLevel(v.GetString("log_level")),
+		GinMode:                v.GetString("gin_mode"),
+		EthereumRPCURL:         v.GetString("ethereum_rpc_url"),
+		EthereumWSURL:          v.GetString("ethereum_ws_url"),
+		RedisURL:               v.GetString("redis_url"),
+		KafkaBrokers:           v.GetStringSlice("kafka_brokers"),
+		WatchedAddresses:       v.GetStringSlice("watched_addresses"),
+		CandidateAddresses:     v.GetStringSlice("candidate_addresses"),
+		SkipFailedTransactions: v.GetBool("skip_failed_transactions"),
+		ABIFiles:               v.GetStringSlice("abi_files"),
+		StateAutoMigrate:       v.GetBool("state_auto_migrate"),
+		ENS: ENSConfig{
+			Enabled:   v.GetBool("ens.enabled"),
+			CacheSize: v.GetInt("ens.cache_size"),
+			CacheTTL:  v.GetDuration("ens.cache_ttl"),
+		},
+		TokenMetadata: TokenMetadataConfig{
+			Enabled:   v.GetBool("token_metadata.enabled"),
+			CacheSize: v.GetInt("token_metadata.cache_size"),
+			CacheTTL:  v.GetDuration("token_metadata.cache_ttl"),
+		},
+		Pricing: PricingConfig{
+			Enabled:         v.GetBool("pricing.enabled"),
+			Backend:         v.GetString("pricing.backend"),
+			ChainlinkFeed:   v.GetString("pricing.chainlink_feed"),
+			CoinGeckoURL:    v.GetString("pricing.coingecko_url"),
+			CoinGeckoAPIKey: v.GetString("pricing.coingecko_api_key"),
+			CoinGeckoCoinID: v.GetString("pricing.coingecko_coin_id"),
+		},
+		TransactionLookupMaxHashes: v.GetInt("transaction_lookup_max_hashes"),
+		PayloadMaxBytes:            v.GetInt("payload_max_bytes"),
+		ObjectStore: ObjectStoreConfig{
+			Endpoint:  v.GetString("object_store.endpoint"),
+			Bucket:    v.GetString("object_store.bucket"),
+			Region:    v.GetString("object_store.region"),
+			AccessKey: v.GetString("object_store.access_key"),
+			SecretKey: v.GetString("object_store.secret_key"),
+		},
 	}
 
 	// Validate configuration
@@ -0,0 +1,37 @@
+package address
+
+import "context"
+
+type contextKey int
+
+const (
+	actorKey contextKey = iota
+	tenantKey
+)
+
+// ActorUnknown is reported when a watch list change carries no actor
+const ActorUnknown = "unknown"
+
+// WithActor returns a context recording who changes the watch list
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// WithTenant returns a context recording which tenant a watch list change belongs to
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey, tenant)
+}
+
+// ActorFromContext returns the actor stored in ctx, or ActorUnknown
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey).(string); ok && actor != "" {
+		return actor
+	}
+	return ActorUnknown
+}
+
+// TenantFromContext returns the tenant stored in ctx, or an empty string
+func TenantFromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey).(string)
+	return tenant
+}
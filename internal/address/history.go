@@ -0,0 +1,51 @@
+package address
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+)
+
+// WatchChange records a single add/remove of an address on the watch list.
+// The change applies to blocks from EffectiveBlock onwards.
+type WatchChange struct {
+	Address        string    `json:"address"`
+	Action         string    `json:"action"`
+	EffectiveBlock uint64    `json:"effective_block"`
+	Actor          string    `json:"actor"`
+	Tenant         string    `json:"tenant,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// HistoryStore persists watch list change history
+type HistoryStore interface {
+	// Record appends a change to the history of its address
+	Record(ctx context.Context, change WatchChange) error
+
+	// Changes returns the history of an address in the order it was recorded
+	Changes(ctx context.Context, address string) ([]WatchChange, error)
+}
+
+// WatchedAt reports whether the address was watched at the given block according to its history.
+// It also returns the change that determined the answer, nil when the address was never watched by then.
+func WatchedAt(ctx context.Context, store HistoryStore, address string, block uint64) (bool, *WatchChange, error) {
+	changes, err := store.Changes(ctx, address)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var last *WatchChange
+	for i := range changes {
+		if changes[i].EffectiveBlock <= block {
+			last = &changes[i]
+		}
+	}
+	if last == nil {
+		return false, nil, nil
+	}
+	return last.Action == ChangeAdded, last, nil
+}
@@ -0,0 +1,86 @@
+package address
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// BlockNumberFunc returns the current chain head block number
+type BlockNumberFunc func(ctx context.Context) (uint64, error)
+
+// historyAddressWatcher decorates a Watcher and records every change with the block it takes effect from
+type historyAddressWatcher struct {
+	Watcher
+	logger      *slog.Logger
+	store       HistoryStore
+	blockNumber BlockNumberFunc
+
+	mu       sync.Mutex
+	lastHead uint64
+}
+
+// NewHistoryAddressWatcher wraps watcher so every add/remove is persisted to the history store
+func NewHistoryAddressWatcher(logger *slog.Logger, watcher Watcher, store HistoryStore, blockNumber BlockNumberFunc) *historyAddressWatcher {
+	return &historyAddressWatcher{
+		Watcher:     watcher,
+		logger:      logger,
+		store:       store,
+		blockNumber: blockNumber,
+	}
+}
+
+func (w *historyAddressWatcher) AddAddresses(ctx context.Context, addresses []string) {
+	w.Watcher.AddAddresses(ctx, addresses)
+	w.record(ctx, ChangeAdded, addresses)
+}
+
+func (w *historyAddressWatcher) RemoveAddresses(ctx context.Context, addresses []string) {
+	w.Watcher.RemoveAddresses(ctx, addresses)
+	w.record(ctx, ChangeRemoved, addresses)
+}
+
+// record persists the change; it applies from the block after the current head
+func (w *historyAddressWatcher) record(ctx context.Context, action string, addresses []string) {
+	if len(addresses) == 0 {
+		return
+	}
+
+	effectiveBlock := w.effectiveBlock(ctx)
+	now := time.Now().UTC()
+	for _, address := range addresses {
+		change := WatchChange{
+			Address:        address,
+			Action:         action,
+			EffectiveBlock: effectiveBlock,
+			Actor:          ActorFromContext(ctx),
+			Tenant:         TenantFromContext(ctx),
+			Timestamp:      now,
+		}
+		if err := w.store.Record(ctx, change); err != nil {
+			w.logger.Error("Failed to record watch change",
+				"error", err,
+				"address", address,
+				"action", action,
+			)
+		}
+	}
+}
+
+// effectiveBlock returns head+1, falling back to the last known head if the chain is unreachable
+func (w *historyAddressWatcher) effectiveBlock(ctx context.Context) uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	head, err := w.blockNumber(ctx)
+	if err != nil {
+		w.logger.Warn("Failed to get chain head for watch history, using last known head",
+			"error", err,
+			"last_head", w.lastHead,
+		)
+		head = w.lastHead
+	}
+	w.lastHead = head
+	return head + 1
+}
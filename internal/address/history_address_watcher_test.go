@@ -0,0 +1,81 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryAddressWatcher_WatchedAt(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	store := NewInMemoryHistoryStore()
+
+	head := uint64(100)
+	watcher := NewHistoryAddressWatcher(logger, NewInMemoryAddressWatcher(), store,
+		func(context.Context) (uint64, error) { return head, nil },
+	)
+
+	ctx := WithActor(context.Background(), "ops")
+	addr := "0xAbC0000000000000000000000000000000000001"
+
+	// Added while head is 100, so watched from block 101
+	watcher.AddAddresses(ctx, []string{addr})
+	assert.True(t, watcher.IsWatched(ctx, addr))
+
+	// Removed while head is 200, so not watched from block 201
+	head = 200
+	watcher.RemoveAddresses(ctx, []string{addr})
+
+	cases := []struct {
+		block   uint64
+		watched bool
+	}{
+		{block: 50, watched: false},
+		{block: 100, watched: false},
+		{block: 101, watched: true},
+		{block: 200, watched: true},
+		{block: 201, watched: false},
+	}
+	for _, tc := range cases {
+		watched, _, err := WatchedAt(ctx, store, addr, tc.block)
+		require.NoError(t, err)
+		assert.Equal(t, tc.watched, watched, "block %d", tc.block)
+	}
+
+	// Lookups are case-insensitive and report the deciding change
+	watched, change, err := WatchedAt(ctx, store, "0xabc0000000000000000000000000000000000001", 150)
+	require.NoError(t, err)
+	assert.True(t, watched)
+	require.NotNil(t, change)
+	assert.Equal(t, ChangeAdded, change.Action)
+	assert.Equal(t, uint64(101), change.EffectiveBlock)
+	assert.Equal(t, "ops", change.Actor)
+}
+
+func TestHistoryAddressWatcher_HeadUnavailable(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	store := NewInMemoryHistoryStore()
+
+	var headErr error
+	watcher := NewHistoryAddressWatcher(logger, NewInMemoryAddressWatcher(), store,
+		func(context.Context) (uint64, error) { return 100, headErr },
+	)
+
+	ctx := context.Background()
+	watcher.AddAddresses(ctx, []string{"0x1"})
+
+	// The last known head is reused when the node is unreachable
+	headErr = errors.New("node unreachable")
+	watcher.RemoveAddresses(ctx, []string{"0x1"})
+
+	changes, err := store.Changes(ctx, "0x1")
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, uint64(101), changes[1].EffectiveBlock)
+	assert.Equal(t, ChangeRemoved, changes[1].Action)
+}
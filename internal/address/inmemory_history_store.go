@@ -0,0 +1,37 @@
+package address
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// inMemoryHistoryStore implements HistoryStore in process memory
+type inMemoryHistoryStore struct {
+	mu      sync.RWMutex
+	changes map[string][]WatchChange
+}
+
+// NewInMemoryHistoryStore creates a non-persistent HistoryStore
+func NewInMemoryHistoryStore() *inMemoryHistoryStore {
+	return &inMemoryHistoryStore{
+		changes: make(map[string][]WatchChange),
+	}
+}
+
+func (s *inMemoryHistoryStore) Record(_ context.Context, change WatchChange) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := strings.ToLower(change.Address)
+	s.changes[key] = append(s.changes[key], change)
+	return nil
+}
+
+func (s *inMemoryHistoryStore) Changes(_ context.Context, address string) ([]WatchChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	changes := s.changes[strings.ToLower(address)]
+	out := make([]WatchChange, len(changes))
+	copy(out, changes)
+	return out, nil
+}
@@ -0,0 +1,83 @@
+package address
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"deblock/internal/idgen"
+	"deblock/internal/pubsub"
+)
+
+// publishingAddressWatcher decorates a Watcher and publishes watch list changes
+// to the control topic
+type publishingAddressWatcher struct {
+	Watcher
+	logger      *slog.Logger
+	publisher   pubsub.Publisher
+	idGenerator idgen.Generator
+}
+
+// PublishingOption allows configuring optional publishing watcher behavior
+type PublishingOption func(*publishingAddressWatcher)
+
+// WithEventIDGenerator replaces the ULID generator used to assign event IDs
+func WithEventIDGenerator(generator idgen.Generator) PublishingOption {
+	return func(w *publishingAddressWatcher) {
+		w.idGenerator = generator
+	}
+}
+
+// NewPublishingAddressWatcher wraps watcher so every add/remove emits a control event
+func NewPublishingAddressWatcher(logger *slog.Logger, watcher Watcher, publisher pubsub.Publisher, opts ...PublishingOption) *publishingAddressWatcher {
+	w := &publishingAddressWatcher{
+		Watcher:     watcher,
+		logger:      logger,
+		publisher:   publisher,
+		idGenerator: idgen.NewULIDGenerator(),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+func (w *publishingAddressWatcher) AddAddresses(ctx context.Context, addresses []string) {
+	w.Watcher.AddAddresses(ctx, addresses)
+	w.publishChange(ctx, pubsub.EventAddressWatchAdded, addresses)
+}
+
+func (w *publishingAddressWatcher) RemoveAddresses(ctx context.Context, addresses []string) {
+	w.Watcher.RemoveAddresses(ctx, addresses)
+	w.publishChange(ctx, pubsub.EventAddressWatchRemoved, addresses)
+}
+
+// publishChange emits a watch change event; failures are logged since the change already applied
+func (w *publishingAddressWatcher) publishChange(ctx context.Context, eventType string, addresses []string) {
+	if len(addresses) == 0 {
+		return
+	}
+
+	event := &pubsub.WatchChangeEvent{
+		EventID:   w.idGenerator.NewID(),
+		Type:      eventType,
+		Addresses: addresses,
+		Actor:     ActorFromContext(ctx),
+		Tenant:    TenantFromContext(ctx),
+		Timestamp: time.Now().UTC(),
+	}
+
+	msg, err := json.Marshal(event)
+	if err != nil {
+		w.logger.Error("Failed to marshal watch change event", "error", err)
+		return
+	}
+	if err := w.publisher.Publish(pubsub.WithEventID(ctx, event.EventID), pubsub.TopicControl, msg); err != nil {
+		w.logger.Error("Failed to publish watch change event",
+			"error", err,
+			"type", eventType,
+			"count", len(addresses),
+		)
+	}
+}
@@ -0,0 +1,85 @@
+package address
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"deblock/internal/pubsub"
+	"deblock/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPublishingAddressWatcher_PublishesChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockPublisher := mocks.NewMockPublisher(ctrl)
+	watcher := NewPublishingAddressWatcher(logger, NewInMemoryAddressWatcher(), mockPublisher)
+
+	var events []pubsub.WatchChangeEvent
+	mockPublisher.EXPECT().
+		Publish(gomock.Any(), pubsub.TopicControl, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, msg []byte) error {
+			var event pubsub.WatchChangeEvent
+			require.NoError(t, json.Unmarshal(msg, &event))
+			events = append(events, event)
+			return nil
+		}).
+		Times(2)
+
+	ctx := WithTenant(WithActor(context.Background(), "ops@example.com"), "acme")
+	watcher.AddAddresses(ctx, []string{"0x1234", "0x5678"})
+	assert.True(t, watcher.IsWatched(ctx, "0x1234"), "Added address should be watched")
+
+	watcher.RemoveAddresses(ctx, []string{"0x1234"})
+	assert.False(t, watcher.IsWatched(ctx, "0x1234"), "Removed address should not be watched")
+
+	require.Len(t, events, 2)
+	assert.Equal(t, pubsub.EventAddressWatchAdded, events[0].Type)
+	assert.Equal(t, []string{"0x1234", "0x5678"}, events[0].Addresses)
+	assert.Equal(t, "ops@example.com", events[0].Actor)
+	assert.Equal(t, "acme", events[0].Tenant)
+	assert.Equal(t, pubsub.EventAddressWatchRemoved, events[1].Type)
+	assert.Equal(t, []string{"0x1234"}, events[1].Addresses)
+}
+
+func TestPublishingAddressWatcher_PublishFailureKeepsChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockPublisher := mocks.NewMockPublisher(ctrl)
+	watcher := NewPublishingAddressWatcher(logger, NewInMemoryAddressWatcher(), mockPublisher)
+
+	mockPublisher.EXPECT().Publish(gomock.Any(), pubsub.TopicControl, gomock.Any()).Return(errors.New("broker down"))
+
+	ctx := context.Background()
+	watcher.AddAddresses(ctx, []string{"0x1234"})
+	assert.True(t, watcher.IsWatched(ctx, "0x1234"), "Change should apply even if the event cannot be published")
+}
+
+func TestPublishingAddressWatcher_SkipsEmptyChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockPublisher := mocks.NewMockPublisher(ctrl)
+	watcher := NewPublishingAddressWatcher(logger, NewInMemoryAddressWatcher(), mockPublisher)
+
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	watcher.AddAddresses(context.Background(), nil)
+}
+
+func TestActorFromContext(t *testing.T) {
+	assert.Equal(t, ActorUnknown, ActorFromContext(context.Background()))
+	assert.Equal(t, "config", ActorFromContext(WithActor(context.Background(), "config")))
+	assert.Empty(t, TenantFromContext(context.Background()))
+}
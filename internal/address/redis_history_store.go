@@ -0,0 +1,56 @@
+package address
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+// historyKeyPrefix prefixes the Redis list holding the history of one address
+const historyKeyPrefix = "deblock:watch_history:"
+
+// redisHistoryStore implements HistoryStore with one Redis list per address
+type redisHistoryStore struct {
+	client goredislib.UniversalClient
+}
+
+// NewRedisHistoryStore creates a HistoryStore backed by Redis
+func NewRedisHistoryStore(client goredislib.UniversalClient) *redisHistoryStore {
+	return &redisHistoryStore{client: client}
+}
+
+func (s *redisHistoryStore) Record(ctx context.Context, change WatchChange) error {
+	data, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("failed to marshal watch change: %w", err)
+	}
+	if err := s.client.RPush(ctx, historyKey(change.Address), data).Err(); err != nil {
+		return fmt.Errorf("failed to record watch change: %w", err)
+	}
+	return nil
+}
+
+func (s *redisHistoryStore) Changes(ctx context.Context, address string) ([]WatchChange, error) {
+	items, err := s.client.LRange(ctx, historyKey(address), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read watch history: %w", err)
+	}
+
+	changes := make([]WatchChange, 0, len(items))
+	for _, item := range items {
+		var change WatchChange
+		if err := json.Unmarshal([]byte(item), &change); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal watch change: %w", err)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// historyKey is case-insensitive so checksummed and lowercase lookups agree
+func historyKey(address string) string {
+	return historyKeyPrefix + strings.ToLower(address)
+}
@@ -0,0 +1,63 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"deblock/internal/address"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AddressWatchedResponse answers whether an address was watched at a block
+type AddressWatchedResponse struct {
+	Address    string               `json:"address"`
+	Block      uint64               `json:"block"`
+	Watched    bool                 `json:"watched"`
+	LastChange *address.WatchChange `json:"last_change"`
+}
+
+// addressWatchedAt godoc
+// @Summary Was address watched at block
+// @Description Check from the persisted watch list history whether an address was monitored at a given block
+// @Tags addresses
+// @Accept json
+// @Produce json
+// @Param address path string true "Address"
+// @Param block query int true "Block number"
+// @Success 200 {object} AddressWatchedResponse
+// @Failure 400 {object} ErrorResponse "Invalid block number"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Failure 501 {object} ErrorResponse "Watch history not enabled"
+// @Router /addresses/{address}/watched [get]
+func (api *apiDetails) addressWatchedAt(c *gin.Context) {
+	if api.watchHistory == nil {
+		createErrorResponse(c, http.StatusNotImplemented, "Watch history is not enabled")
+		return
+	}
+
+	addr := c.Param("address")
+	block, err := strconv.ParseUint(c.Query("block"), 10, 64)
+	if err != nil {
+		createErrorResponse(c, http.StatusBadRequest, "Query parameter block must be a block number")
+		return
+	}
+
+	watched, lastChange, err := address.WatchedAt(c.Request.Context(), api.watchHistory, addr, block)
+	if err != nil {
+		api.logger.Error("Failed to query watch history",
+			"error", err,
+			"address", addr,
+			"block", block,
+		)
+		createErrorResponse(c, http.StatusInternalServerError, "Failed to query watch history")
+		return
+	}
+
+	c.JSON(http.StatusOK, &AddressWatchedResponse{
+		Address:    addr,
+		Block:      block,
+		Watched:    watched,
+		LastChange: lastChange,
+	})
+}
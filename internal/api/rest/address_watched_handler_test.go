@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"deblock/internal/address"
+)
+
+// TestAddressWatchedAt tests the addressWatchedAt handler
+func TestAddressWatchedAt(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	store := address.NewInMemoryHistoryStore()
+	require.NoError(t, store.Record(context.Background(), address.WatchChange{
+		Address:        "0x1234",
+		Action:         address.ChangeAdded,
+		EffectiveBlock: 101,
+		Actor:          "config",
+	}))
+
+	apiDetails := &apiDetails{
+		logger:       setupTestLogger(),
+		watchHistory: store,
+	}
+
+	call := func(block string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "address", Value: "0x1234"}}
+		c.Request, _ = http.NewRequest(http.MethodGet, "/addresses/0x1234/watched?block="+block, nil)
+		apiDetails.addressWatchedAt(c)
+		return w
+	}
+
+	t.Run("Watched At Block", func(t *testing.T) {
+		w := call("150")
+		assert.Equal(t, http.StatusOK, w.Code, "HTTP status should be 200 OK")
+
+		var response AddressWatchedResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Watched)
+		assert.Equal(t, uint64(150), response.Block)
+		require.NotNil(t, response.LastChange)
+		assert.Equal(t, "config", response.LastChange.Actor)
+	})
+
+	t.Run("Not Yet Watched", func(t *testing.T) {
+		w := call("100")
+		assert.Equal(t, http.StatusOK, w.Code, "HTTP status should be 200 OK")
+
+		var response AddressWatchedResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.False(t, response.Watched)
+		assert.Nil(t, response.LastChange)
+	})
+
+	t.Run("Invalid Block", func(t *testing.T) {
+		w := call("latest")
+		assert.Equal(t, http.StatusBadRequest, w.Code, "HTTP status should be 400 Bad Request")
+	})
+}
@@ -0,0 +1,20 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// candidateStats godoc
+// @Summary Watch-only candidate statistics
+// @Description Get match counts for candidate addresses monitored in watch-only mode (never published)
+// @Tags txmonitor
+// @Accept json
+// @Produce json
+// @Success 200 {object} stats.CandidateStats
+// @Router /txmonitor/candidates/stats [get]
+func (api *apiDetails) candidateStats(c *gin.Context) {
+	stats := api.service.CandidateStats(c.Request.Context())
+	c.JSON(http.StatusOK, stats)
+}
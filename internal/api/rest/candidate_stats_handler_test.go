@@ -0,0 +1,55 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"deblock/internal/stats"
+	"deblock/mocks"
+)
+
+// TestCandidateStats tests the candidateStats handler
+func TestCandidateStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTxMonitorService := mocks.NewMockTxMonitorService(ctrl)
+	mockTxMonitorService.EXPECT().
+		CandidateStats(gomock.Any()).
+		Return(stats.CandidateStats{
+			Enabled:            true,
+			CandidateAddresses: 2,
+			MatchedTxCount:     5,
+			MatchesByAddress:   map[string]uint64{"0x1234": 5},
+		})
+
+	apiDetails := &apiDetails{
+		logger:  setupTestLogger(),
+		service: mockTxMonitorService,
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/txmonitor/candidates/stats", nil)
+
+	apiDetails.candidateStats(c)
+
+	assert.Equal(t, http.StatusOK, w.Code, "HTTP status should be 200 OK")
+
+	var response stats.CandidateStats
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err, "Should be able to parse response JSON")
+
+	assert.True(t, response.Enabled)
+	assert.Equal(t, 2, response.CandidateAddresses)
+	assert.Equal(t, uint64(5), response.MatchedTxCount)
+	assert.Equal(t, uint64(5), response.MatchesByAddress["0x1234"])
+}
@@ -0,0 +1,114 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"deblock/internal/blockchain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// txHashPattern matches a 0x-prefixed 32-byte transaction hash
+var txHashPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+
+// LookupTransactionsRequest lists transaction hashes to verify
+type LookupTransactionsRequest struct {
+	Hashes []string `json:"hashes"`
+}
+
+// TransactionResponse is the REST representation of a transaction
+type TransactionResponse struct {
+	Hash        string `json:"hash"`
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Amount      string `json:"amount"`
+	Fees        string `json:"fees"`
+	BlockNumber string `json:"block_number"`
+	Status      string `json:"status"`
+	MethodID    string `json:"method_id,omitempty"`
+}
+
+// LookupTransactionsResponse returns found transactions and hashes without a receipt
+type LookupTransactionsResponse struct {
+	Transactions []TransactionResponse `json:"transactions"`
+	NotFound     []string              `json:"not_found"`
+}
+
+// lookupTransactions godoc
+// @Summary Look up transactions
+// @Description Fetch receipts for many transaction hashes at once, for reconciliation jobs
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Param request body LookupTransactionsRequest true "Transaction hashes"
+// @Success 200 {object} LookupTransactionsResponse
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Failure 501 {object} ErrorResponse "Transaction lookup not enabled"
+// @Router /transactions/lookup [post]
+func (api *apiDetails) lookupTransactions(c *gin.Context) {
+	if api.blockchainClient == nil {
+		createErrorResponse(c, http.StatusNotImplemented, "Transaction lookup is not enabled")
+		return
+	}
+
+	var req LookupTransactionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		createErrorResponse(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if len(req.Hashes) == 0 {
+		createErrorResponse(c, http.StatusBadRequest, "At least one hash is required")
+		return
+	}
+	if len(req.Hashes) > api.maxLookupHashes {
+		createErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("At most %d hashes are allowed", api.maxLookupHashes))
+		return
+	}
+	for _, hash := range req.Hashes {
+		if !txHashPattern.MatchString(hash) {
+			createErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Invalid transaction hash %q", hash))
+			return
+		}
+	}
+
+	txs, err := api.blockchainClient.GetTransactionReceipts(c.Request.Context(), req.Hashes)
+	if err != nil {
+		api.logger.Error("Failed to look up transactions",
+			"error", err,
+			"count", len(req.Hashes),
+		)
+		createErrorResponse(c, http.StatusInternalServerError, "Failed to look up transactions")
+		return
+	}
+
+	resp := LookupTransactionsResponse{
+		Transactions: make([]TransactionResponse, 0, len(txs)),
+		NotFound:     []string{},
+	}
+	for i, tx := range txs {
+		if tx == nil {
+			resp.NotFound = append(resp.NotFound, req.Hashes[i])
+			continue
+		}
+		resp.Transactions = append(resp.Transactions, newTransactionResponse(tx))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// newTransactionResponse converts a blockchain transaction to its REST representation
+func newTransactionResponse(tx *blockchain.Transaction) TransactionResponse {
+	return TransactionResponse{
+		Hash:        tx.Hash,
+		Source:      tx.Source,
+		Destination: tx.Destination,
+		Amount:      bigIntString(tx.Amount),
+		Fees:        bigIntString(tx.Fees),
+		BlockNumber: bigIntString(tx.BlockNumber),
+		Status:      string(tx.Status),
+		MethodID:    tx.MethodID,
+	}
+}
@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"deblock/internal/blockchain"
+	"deblock/mocks"
+)
+
+// TestLookupTransactions tests the lookupTransactions handler
+func TestLookupTransactions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	foundHash := "0x" + strings.Repeat("a", 64)
+	missingHash := "0x" + strings.Repeat("b", 64)
+
+	call := func(api *apiDetails, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/transactions/lookup", bytes.NewBufferString(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		api.lookupTransactions(c)
+		return w
+	}
+
+	t.Run("Successful Lookup", func(t *testing.T) {
+		mockClient := mocks.NewMockClient(ctrl)
+		mockClient.EXPECT().
+			GetTransactionReceipts(gomock.Any(), []string{foundHash, missingHash}).
+			Return([]*blockchain.Transaction{
+				{
+					Hash:        foundHash,
+					Source:      "0x1234",
+					Destination: "0x5678",
+					Amount:      big.NewInt(100),
+					Fees:        big.NewInt(10),
+					BlockNumber: big.NewInt(42),
+					Status:      blockchain.TransactionStatusSuccess,
+				},
+				nil,
+			}, nil)
+
+		api := &apiDetails{logger: setupTestLogger(), blockchainClient: mockClient, maxLookupHashes: 10}
+		w := call(api, `{"hashes":["`+foundHash+`","`+missingHash+`"]}`)
+		assert.Equal(t, http.StatusOK, w.Code, "HTTP status should be 200 OK")
+
+		var response LookupTransactionsResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Transactions, 1)
+		assert.Equal(t, foundHash, response.Transactions[0].Hash)
+		assert.Equal(t, "100", response.Transactions[0].Amount)
+		assert.Equal(t, "42", response.Transactions[0].BlockNumber)
+		assert.Equal(t, "success", response.Transactions[0].Status)
+		assert.Equal(t, []string{missingHash}, response.NotFound)
+	})
+
+	t.Run("Too Many Hashes", func(t *testing.T) {
+		api := &apiDetails{logger: setupTestLogger(), blockchainClient: mocks.NewMockClient(ctrl), maxLookupHashes: 1}
+		w := call(api, `{"hashes":["`+foundHash+`","`+missingHash+`"]}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code, "HTTP status should be 400 Bad Request")
+	})
+
+	t.Run("Invalid Hash", func(t *testing.T) {
+		api := &apiDetails{logger: setupTestLogger(), blockchainClient: mocks.NewMockClient(ctrl), maxLookupHashes: 10}
+		w := call(api, `{"hashes":["0x1234"]}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code, "HTTP status should be 400 Bad Request")
+	})
+
+	t.Run("Client Failure", func(t *testing.T) {
+		mockClient := mocks.NewMockClient(ctrl)
+		mockClient.EXPECT().GetTransactionReceipts(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc down"))
+
+		api := &apiDetails{logger: setupTestLogger(), blockchainClient: mockClient, maxLookupHashes: 10}
+		w := call(api, `{"hashes":["`+foundHash+`"]}`)
+		assert.Equal(t, http.StatusInternalServerError, w.Code, "HTTP status should be 500 Internal Server Error")
+	})
+}
@@ -2,9 +2,12 @@ package rest
 
 import (
 	"context"
+	"deblock/internal/address"
+	"deblock/internal/blockchain"
 	"deblock/internal/txmonitor"
 	"fmt"
 	"log/slog"
+	"math/big"
 	"net/http"
 	"os"
 	"os/signal"
@@ -28,6 +31,9 @@ const (
 // @description Endpoints:
 // @description - POST /txmonitor/start: Start monitoring blockchain transactions
 // @description - POST /txmonitor/stop: Stop monitoring blockchain transactions
+// @description - GET /txmonitor/candidates/stats: Watch-only candidate address statistics
+// @description - GET /addresses/{address}/watched: Check whether an address was watched at a block
+// @description - POST /transactions/lookup: Look up many transactions at once
 // @description - GET /health: Check service health
 // @termsOfService http://swagger.io/terms/
 
@@ -48,14 +54,36 @@ type RestApi interface {
 }
 
 type apiDetails struct {
-	logger     *slog.Logger
-	server     *http.Server
-	service    txmonitor.TxMonitorService
-	serverPort string
+	logger       *slog.Logger
+	server       *http.Server
+	service      txmonitor.TxMonitorService
+	serverPort   string
+	watchHistory address.HistoryStore
+
+	blockchainClient blockchain.Client
+	maxLookupHashes  int
+}
+
+// ApiOption allows configuring optional api dependencies
+type ApiOption func(*apiDetails)
+
+// WithWatchHistory enables watch list history queries
+func WithWatchHistory(store address.HistoryStore) ApiOption {
+	return func(api *apiDetails) {
+		api.watchHistory = store
+	}
+}
+
+// WithTransactionLookup enables batch transaction lookups of up to maxHashes hashes per request
+func WithTransactionLookup(client blockchain.Client, maxHashes int) ApiOption {
+	return func(api *apiDetails) {
+		api.blockchainClient = client
+		api.maxLookupHashes = maxHashes
+	}
 }
 
 // NewApi creates new api instance, otherwise returns error
-func NewApi(logger *slog.Logger, port string, service txmonitor.TxMonitorService) (RestApi, error) {
+func NewApi(logger *slog.Logger, port string, service txmonitor.TxMonitorService, opts ...ApiOption) (RestApi, error) {
 	if logger == nil {
 		return nil, fmt.Errorf(nilArgErr, "logger")
 	}
@@ -73,6 +101,9 @@ func NewApi(logger *slog.Logger, port string, service txmonitor.TxMonitorService
 		service:    service,
 		serverPort: port,
 	}
+	for _, opt := range opts {
+		opt(api)
+	}
 
 	router := api.setupRouter()
 	api.server = &http.Server{
@@ -155,3 +186,11 @@ func (a *apiDetails) GracefulStopServer() {
 	}
 	a.logger.Info("Server exiting")
 }
+
+// bigIntString formats a big.Int as a decimal string, empty when nil
+func bigIntString(v *big.Int) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
@@ -63,6 +63,13 @@ func (api *apiDetails) setupRouter() *gin.Engine {
 		// Transaction monitor routes
 		apiV1.POST("/txmonitor/start", api.startTxMonitor)
 		apiV1.POST("/txmonitor/stop", api.stopTxMonitor)
+		apiV1.GET("/txmonitor/candidates/stats", api.candidateStats)
+
+		// Address routes
+		apiV1.GET("/addresses/:address/watched", api.addressWatchedAt)
+
+		// Transaction routes
+		apiV1.POST("/transactions/lookup", api.lookupTransactions)
 	}
 
 	// Log all registered routes
@@ -0,0 +1,72 @@
+package blockchain
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// selectorLength is the size of the 4-byte method selector prefixing calldata
+const selectorLength = 4
+
+// ABIRegistry resolves 4-byte method selectors to method names from configured ABIs
+type ABIRegistry struct {
+	mu      sync.RWMutex
+	methods map[[selectorLength]byte]string
+}
+
+// NewABIRegistry creates an empty ABI registry
+func NewABIRegistry() *ABIRegistry {
+	return &ABIRegistry{
+		methods: make(map[[selectorLength]byte]string),
+	}
+}
+
+// Register parses a JSON ABI and registers all of its methods
+func (r *ABIRegistry) Register(reader io.Reader) error {
+	parsed, err := abi.JSON(reader)
+	if err != nil {
+		return fmt.Errorf("failed to parse abi: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, method := range parsed.Methods {
+		var selector [selectorLength]byte
+		copy(selector[:], method.ID)
+		r.methods[selector] = method.Name
+	}
+	return nil
+}
+
+// RegisterFile registers all methods of the JSON ABI stored at path
+func (r *ABIRegistry) RegisterFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open abi file: %w", err)
+	}
+	defer f.Close()
+
+	if err := r.Register(f); err != nil {
+		return fmt.Errorf("failed to register abi file %s: %w", path, err)
+	}
+	return nil
+}
+
+// MethodName returns the method name for the selector at the start of calldata
+func (r *ABIRegistry) MethodName(data []byte) (string, bool) {
+	if len(data) < selectorLength {
+		return "", false
+	}
+
+	var selector [selectorLength]byte
+	copy(selector[:], data[:selectorLength])
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	name, ok := r.methods[selector]
+	return name, ok
+}
@@ -0,0 +1,59 @@
+package blockchain
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const erc20TransferABI = `[{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}]`
+
+func TestABIRegistry_MethodName(t *testing.T) {
+	registry := NewABIRegistry()
+	require.NoError(t, registry.Register(strings.NewReader(erc20TransferABI)))
+
+	// transfer(address,uint256) selector followed by arguments
+	data := hexutil.MustDecode("0xa9059cbb000000000000000000000000000000000000000000000000000000000000dead")
+	name, ok := registry.MethodName(data)
+	assert.True(t, ok, "transfer selector should be known")
+	assert.Equal(t, "transfer", name)
+
+	_, ok = registry.MethodName(hexutil.MustDecode("0xdeadbeef"))
+	assert.False(t, ok, "unknown selector should not resolve")
+
+	_, ok = registry.MethodName([]byte{0xa9, 0x05})
+	assert.False(t, ok, "short calldata should not resolve")
+}
+
+func TestABIRegistry_RegisterFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "erc20.json")
+	require.NoError(t, os.WriteFile(path, []byte(erc20TransferABI), 0o600))
+
+	registry := NewABIRegistry()
+	require.NoError(t, registry.RegisterFile(path))
+
+	assert.Error(t, registry.RegisterFile(filepath.Join(t.TempDir(), "missing.json")))
+	assert.Error(t, registry.Register(strings.NewReader("not json")))
+}
+
+func TestEthereumClient_DecodeInput(t *testing.T) {
+	registry := NewABIRegistry()
+	require.NoError(t, registry.Register(strings.NewReader(erc20TransferABI)))
+	client := &EthereumClient{abiRegistry: registry}
+
+	tx := &Transaction{}
+	client.decodeInput(tx, hexutil.MustDecode("0xa9059cbb0000"))
+	assert.Equal(t, "0xa9059cbb0000", tx.InputData)
+	assert.Equal(t, "0xa9059cbb", tx.MethodID)
+	assert.Equal(t, "transfer", tx.MethodName)
+
+	plain := &Transaction{}
+	client.decodeInput(plain, nil)
+	assert.Empty(t, plain.InputData, "plain transfers carry no calldata")
+	assert.Empty(t, plain.MethodID)
+}
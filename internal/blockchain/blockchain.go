@@ -5,6 +5,14 @@ import (
 	"math/big"
 )
 
+// TransactionStatus represents the execution outcome of a transaction
+type TransactionStatus string
+
+const (
+	TransactionStatusSuccess TransactionStatus = "success"
+	TransactionStatusFailed  TransactionStatus = "failed"
+)
+
 // Transaction represents a generic blockchain transaction
 type Transaction struct {
 	Source      string
@@ -13,6 +21,34 @@ type Transaction struct {
 	Fees        *big.Int
 	Hash        string
 	BlockNumber *big.Int
+	// BlockTimestamp is the unix time of the including block, zero when unknown
+	BlockTimestamp int64
+	Status         TransactionStatus
+	// InputData is the hex-encoded calldata, empty for plain value transfers
+	InputData string
+	// MethodID is the hex-encoded 4-byte selector of a contract call
+	MethodID string
+	// MethodName is the decoded method name when the ABI is known
+	MethodName string
+	// SourceENS and DestinationENS are primary ENS names when enrichment is enabled
+	SourceENS      string
+	DestinationENS string
+	// Token is the decoded ERC-20 transfer carried by the calldata, if any
+	Token *TokenTransfer
+	// AmountUSD is the approximate USD value of Amount at block time when pricing is enabled
+	AmountUSD string
+}
+
+// TokenTransfer represents an ERC-20 token movement decoded from calldata
+type TokenTransfer struct {
+	Contract  string
+	From      string
+	To        string
+	RawAmount *big.Int
+	// Symbol, Decimals and Amount are only set once token metadata is resolved
+	Symbol   string
+	Decimals uint8
+	Amount   string
 }
 
 // Block represents a generic blockchain block
@@ -37,6 +73,10 @@ type Client interface {
 	// GetTransactionReceipt retrieves the receipt of a transaction
 	GetTransactionReceipt(ctx context.Context, txHash string) (*Transaction, error)
 
+	// GetTransactionReceipts retrieves receipts of many transactions using batched calls.
+	// The result is aligned with txHashes; unknown transactions are nil.
+	GetTransactionReceipts(ctx context.Context, txHashes []string) ([]*Transaction, error)
+
 	// Close terminates the connection to the blockchain
 	Close(ctx context.Context) error
 }
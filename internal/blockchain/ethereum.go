@@ -7,21 +7,37 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// maxBatchSize bounds the number of requests sent in a single JSON-RPC batch
+const maxBatchSize = 100
+
 // EthereumClient implements the Client interface for Ethereum
 type EthereumClient struct {
-	logger *slog.Logger
-	client *ethclient.Client
-	rpc    *rpc.Client
+	logger      *slog.Logger
+	client      *ethclient.Client
+	rpc         *rpc.Client
+	abiRegistry *ABIRegistry
+}
+
+// EthereumOption allows configuring optional Ethereum client behavior
+type EthereumOption func(*EthereumClient)
+
+// WithABIRegistry decodes method names of contract calls using the given registry
+func WithABIRegistry(registry *ABIRegistry) EthereumOption {
+	return func(e *EthereumClient) {
+		e.abiRegistry = registry
+	}
 }
 
 // NewEthereumClient creates a new Ethereum blockchain client
-func NewEthereumClient(logger *slog.Logger, rpcURL, wsURL string) (*EthereumClient, error) {
+func NewEthereumClient(logger *slog.Logger, rpcURL, wsURL string, opts ...EthereumOption) (*EthereumClient, error) {
 	c, err := ethclient.Dial(wsURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to Ethereum client: %w", err)
@@ -30,7 +46,11 @@ func NewEthereumClient(logger *slog.Logger, rpcURL, wsURL string) (*EthereumClie
 	if err != nil {
 		return nil, fmt.Errorf("failed to create raw rpc client: %w", err)
 	}
-	return &EthereumClient{logger: logger, client: c, rpc: rc}, nil
+	e := &EthereumClient{logger: logger, client: c, rpc: rc}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e, nil
 }
 
 // SubscribeToBlocks starts streaming new blocks converted to generic Block type
@@ -119,14 +139,83 @@ func (e *EthereumClient) GetTransactionReceipt(ctx context.Context, txHash strin
 
 	fees := new(big.Int).Mul(receipt.EffectiveGasPrice, big.NewInt(int64(receipt.GasUsed)))
 
-	return &Transaction{
+	converted := &Transaction{
 		Source:      from.Hex(),
 		Destination: to,
 		Amount:      tx.Value(),
 		Fees:        fees,
 		Hash:        txHash,
 		BlockNumber: receipt.BlockNumber,
-	}, nil
+		Status:      transactionStatus(receipt),
+	}
+	e.decodeInput(converted, tx.Data())
+	return converted, nil
+}
+
+// GetTransactionReceipts retrieves transactions and receipts in batched JSON-RPC calls
+func (e *EthereumClient) GetTransactionReceipts(ctx context.Context, txHashes []string) ([]*Transaction, error) {
+	if e.rpc == nil {
+		return nil, fmt.Errorf("rpc client not initialized")
+	}
+
+	result := make([]*Transaction, len(txHashes))
+	for start := 0; start < len(txHashes); start += maxBatchSize {
+		end := min(start+maxBatchSize, len(txHashes))
+		if err := e.fetchTransactionBatch(ctx, txHashes[start:end], result[start:end]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// fetchTransactionBatch fetches one batch of transactions and receipts into out
+func (e *EthereumClient) fetchTransactionBatch(ctx context.Context, txHashes []string, out []*Transaction) error {
+	txs := make([]*types.Transaction, len(txHashes))
+	receipts := make([]*types.Receipt, len(txHashes))
+	batch := make([]rpc.BatchElem, 0, 2*len(txHashes))
+	for i, txHash := range txHashes {
+		hash := common.HexToHash(txHash)
+		batch = append(batch,
+			rpc.BatchElem{Method: "eth_getTransactionByHash", Args: []interface{}{hash}, Result: &txs[i]},
+			rpc.BatchElem{Method: "eth_getTransactionReceipt", Args: []interface{}{hash}, Result: &receipts[i]},
+		)
+	}
+
+	if err := e.rpc.BatchCallContext(ctx, batch); err != nil {
+		return fmt.Errorf("failed to batch get tx receipts: %w", err)
+	}
+
+	for i, txHash := range txHashes {
+		for _, elem := range batch[2*i : 2*i+2] {
+			if elem.Error != nil {
+				return fmt.Errorf("failed to get tx %s: %w", txHash, elem.Error)
+			}
+		}
+		// Unknown or still pending transactions have no receipt yet
+		if txs[i] == nil || receipts[i] == nil {
+			continue
+		}
+		converted, err := e.convertTransaction(txs[i], receipts[i], receipts[i].BlockNumber)
+		if err != nil {
+			return fmt.Errorf("failed to convert tx %s: %w", txHash, err)
+		}
+		out[i] = converted
+	}
+	return nil
+}
+
+// BlockNumber returns the most recent block number known to the node
+func (e *EthereumClient) BlockNumber(ctx context.Context) (uint64, error) {
+	number, err := e.client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get block number: %w", err)
+	}
+	return number, nil
+}
+
+// CallContract executes a read-only contract call, making the client usable as a contract caller
+func (e *EthereumClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return e.client.CallContract(ctx, msg, blockNumber)
 }
 
 // Close terminates the connection to the blockchain
@@ -162,14 +251,42 @@ func (e *EthereumClient) convertTransaction(tx *types.Transaction, receipt *type
 
 	fees := new(big.Int).Mul(receipt.EffectiveGasPrice, big.NewInt(int64(receipt.GasUsed)))
 
-	return &Transaction{
+	converted := &Transaction{
 		Source:      from.Hex(),
 		Destination: to,
 		Amount:      tx.Value(),
 		Fees:        fees,
 		Hash:        tx.Hash().Hex(),
 		BlockNumber: blockNumber,
-	}, nil
+		Status:      transactionStatus(receipt),
+	}
+	e.decodeInput(converted, tx.Data())
+	return converted, nil
+}
+
+// decodeInput fills calldata, method selector and (when the ABI is known) method name
+func (e *EthereumClient) decodeInput(tx *Transaction, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	tx.InputData = hexutil.Encode(data)
+	if len(data) < selectorLength {
+		return
+	}
+	tx.MethodID = hexutil.Encode(data[:selectorLength])
+	if e.abiRegistry != nil {
+		if name, ok := e.abiRegistry.MethodName(data); ok {
+			tx.MethodName = name
+		}
+	}
+}
+
+// transactionStatus maps the receipt status to the generic transaction status
+func transactionStatus(receipt *types.Receipt) TransactionStatus {
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return TransactionStatusSuccess
+	}
+	return TransactionStatusFailed
 }
 
 // convertBlock converts an Ethereum block to our generic Block type
@@ -207,6 +324,7 @@ func (e *EthereumClient) convertBlock(ctx context.Context, ethBlock *types.Block
 			e.logger.Warn("failed to convert transaction", "hash", tx.Hash().Hex(), "error", err)
 			continue
 		}
+		convertedTx.BlockTimestamp = int64(ethBlock.Time())
 
 		txs = append(txs, *convertedTx)
 	}
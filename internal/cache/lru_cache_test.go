@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRUCache[string, int](2, 0)
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	// Touch "a" so "b" becomes the eviction candidate
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+
+	c.Set("c", 3)
+	_, ok = c.Get("b")
+	assert.False(t, ok, "Least recently used entry should be evicted")
+
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestLRUCache_Expiry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLRUCache[string, string](10, time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set("addr", "vitalik.eth")
+	v, ok := c.Get("addr")
+	assert.True(t, ok)
+	assert.Equal(t, "vitalik.eth", v)
+
+	now = now.Add(2 * time.Minute)
+	_, ok = c.Get("addr")
+	assert.False(t, ok, "Expired entry should not be returned")
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestLRUCache_Overwrite(t *testing.T) {
+	c := NewLRUCache[string, int](1, 0)
+	c.Set("a", 1)
+	c.Set("a", 2)
+
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 1, c.Len())
+}
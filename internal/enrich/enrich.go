@@ -0,0 +1,15 @@
+package enrich
+
+import (
+	"context"
+
+	"deblock/internal/blockchain"
+)
+
+// Enricher attaches additional data to a relevant transaction before it is published
+//
+//go:generate go run go.uber.org/mock/mockgen@latest -source=enrich.go -destination=../../mocks/mock_enricher.go -package=mocks
+type Enricher interface {
+	// Enrich mutates tx in place; errors must leave tx publishable
+	Enrich(ctx context.Context, tx *blockchain.Transaction) error
+}
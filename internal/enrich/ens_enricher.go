@@ -0,0 +1,181 @@
+package enrich
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"deblock/internal/blockchain"
+	"deblock/internal/cache"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ENSRegistryAddress is the ENS registry deployed on Ethereum mainnet and testnets
+const ENSRegistryAddress = "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"
+
+const ensABI = `[
+	{"type":"function","name":"resolver","stateMutability":"view","inputs":[{"name":"node","type":"bytes32"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"name","stateMutability":"view","inputs":[{"name":"node","type":"bytes32"}],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"addr","stateMutability":"view","inputs":[{"name":"node","type":"bytes32"}],"outputs":[{"name":"","type":"address"}]}
+]`
+
+// ensEnricher reverse-resolves ENS names for transaction counterparties
+type ensEnricher struct {
+	logger   *slog.Logger
+	caller   ethereum.ContractCaller
+	registry common.Address
+	abi      abi.ABI
+	cache    *cache.LRUCache[string, string]
+}
+
+// NewENSEnricher creates an enricher resolving primary ENS names through caller.
+// Results, including addresses without a name, are cached to avoid extra RPC calls per tx.
+func NewENSEnricher(logger *slog.Logger, caller ethereum.ContractCaller, cacheSize int, cacheTTL time.Duration) (*ensEnricher, error) {
+	parsed, err := abi.JSON(strings.NewReader(ensABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ens abi: %w", err)
+	}
+	return &ensEnricher{
+		logger:   logger,
+		caller:   caller,
+		registry: common.HexToAddress(ENSRegistryAddress),
+		abi:      parsed,
+		cache:    cache.NewLRUCache[string, string](cacheSize, cacheTTL),
+	}, nil
+}
+
+// Enrich attaches ENS names for source and destination
+func (e *ensEnricher) Enrich(ctx context.Context, tx *blockchain.Transaction) error {
+	var errs []error
+
+	sourceName, err := e.lookup(ctx, tx.Source)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to resolve source ens name: %w", err))
+	}
+	tx.SourceENS = sourceName
+
+	destinationName, err := e.lookup(ctx, tx.Destination)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to resolve destination ens name: %w", err))
+	}
+	tx.DestinationENS = destinationName
+
+	return errors.Join(errs...)
+}
+
+// lookup returns the cached or freshly resolved primary name of address
+func (e *ensEnricher) lookup(ctx context.Context, address string) (string, error) {
+	if !common.IsHexAddress(address) {
+		return "", nil
+	}
+
+	key := strings.ToLower(address)
+	if name, ok := e.cache.Get(key); ok {
+		return name, nil
+	}
+
+	name, err := e.reverseResolve(ctx, common.HexToAddress(address))
+	if err != nil {
+		// Errors are not cached so the next transaction retries
+		return "", err
+	}
+	e.cache.Set(key, name)
+	return name, nil
+}
+
+// reverseResolve looks up the primary name and verifies it resolves back to address
+func (e *ensEnricher) reverseResolve(ctx context.Context, address common.Address) (string, error) {
+	reverseNode := namehash(strings.ToLower(address.Hex()[2:]) + ".addr.reverse")
+	resolver, err := e.callAddress(ctx, e.registry, "resolver", reverseNode)
+	if err != nil || resolver == (common.Address{}) {
+		return "", err
+	}
+
+	name, err := e.callString(ctx, resolver, "name", reverseNode)
+	if err != nil || name == "" {
+		return "", err
+	}
+
+	// Forward-verify the claimed name, anyone can set an arbitrary reverse record
+	forwardNode := namehash(name)
+	forwardResolver, err := e.callAddress(ctx, e.registry, "resolver", forwardNode)
+	if err != nil || forwardResolver == (common.Address{}) {
+		return "", err
+	}
+	resolved, err := e.callAddress(ctx, forwardResolver, "addr", forwardNode)
+	if err != nil {
+		return "", err
+	}
+	if resolved != address {
+		e.logger.Debug("ENS reverse record does not resolve back to address",
+			"address", address.Hex(),
+			"name", name,
+		)
+		return "", nil
+	}
+	return name, nil
+}
+
+func (e *ensEnricher) callAddress(ctx context.Context, to common.Address, method string, node [32]byte) (common.Address, error) {
+	out, err := e.call(ctx, to, method, node)
+	if err != nil || len(out) == 0 {
+		return common.Address{}, err
+	}
+	addr, ok := out[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("unexpected %s result type %T", method, out[0])
+	}
+	return addr, nil
+}
+
+func (e *ensEnricher) callString(ctx context.Context, to common.Address, method string, node [32]byte) (string, error) {
+	out, err := e.call(ctx, to, method, node)
+	if err != nil || len(out) == 0 {
+		return "", err
+	}
+	s, ok := out[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected %s result type %T", method, out[0])
+	}
+	return s, nil
+}
+
+func (e *ensEnricher) call(ctx context.Context, to common.Address, method string, node [32]byte) ([]interface{}, error) {
+	data, err := e.abi.Pack(method, node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s call: %w", method, err)
+	}
+	out, err := e.caller.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", method, err)
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	values, err := e.abi.Unpack(method, out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s result: %w", method, err)
+	}
+	return values, nil
+}
+
+// namehash implements the ENS name hashing algorithm (EIP-137)
+func namehash(name string) [32]byte {
+	var node [32]byte
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		labelHash := crypto.Keccak256([]byte(labels[i]))
+		copy(node[:], crypto.Keccak256(node[:], labelHash))
+	}
+	return node
+}
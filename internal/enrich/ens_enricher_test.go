@@ -0,0 +1,144 @@
+package enrich
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"deblock/internal/blockchain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeENS answers registry and resolver calls from in-memory records
+type fakeENS struct {
+	abi       abi.ABI
+	resolvers map[[32]byte]common.Address
+	names     map[[32]byte]string
+	addrs     map[[32]byte]common.Address
+	calls     int
+	err       error
+}
+
+func newFakeENS(t *testing.T) *fakeENS {
+	parsed, err := abi.JSON(strings.NewReader(ensABI))
+	require.NoError(t, err)
+	return &fakeENS{
+		abi:       parsed,
+		resolvers: map[[32]byte]common.Address{},
+		names:     map[[32]byte]string{},
+		addrs:     map[[32]byte]common.Address{},
+	}
+}
+
+// setPrimaryName registers a reverse record and, when verified, a matching forward record
+func (f *fakeENS) setPrimaryName(address common.Address, name string, verified bool) {
+	resolver := common.HexToAddress("0x4976fb03C32e5B8cfe2b6cCB31c09Ba78EBaBa41")
+	reverseNode := namehash(strings.ToLower(address.Hex()[2:]) + ".addr.reverse")
+	forwardNode := namehash(name)
+	f.resolvers[reverseNode] = resolver
+	f.names[reverseNode] = name
+	f.resolvers[forwardNode] = resolver
+	if verified {
+		f.addrs[forwardNode] = address
+	}
+}
+
+func (f *fakeENS) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	method, err := f.abi.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	var node [32]byte
+	copy(node[:], msg.Data[4:36])
+
+	switch method.Name {
+	case "resolver":
+		return method.Outputs.Pack(f.resolvers[node])
+	case "name":
+		return method.Outputs.Pack(f.names[node])
+	default:
+		return method.Outputs.Pack(f.addrs[node])
+	}
+}
+
+func TestNamehash(t *testing.T) {
+	// Reference value from EIP-137
+	expected := common.HexToHash("0xde9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f")
+	node := namehash("foo.eth")
+	assert.True(t, bytes.Equal(expected[:], node[:]), "namehash(foo.eth) should match EIP-137")
+	assert.Equal(t, [32]byte{}, namehash(""))
+}
+
+func TestENSEnricher_Enrich(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	fake := newFakeENS(t)
+
+	source := common.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+	destination := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	spoofer := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	fake.setPrimaryName(source, "vitalik.eth", true)
+	fake.setPrimaryName(spoofer, "spoofed.eth", false)
+
+	enricher, err := NewENSEnricher(logger, fake, 100, time.Hour)
+	require.NoError(t, err)
+
+	tx := &blockchain.Transaction{Source: source.Hex(), Destination: destination.Hex()}
+	require.NoError(t, enricher.Enrich(context.Background(), tx))
+	assert.Equal(t, "vitalik.eth", tx.SourceENS)
+	assert.Empty(t, tx.DestinationENS, "Address without a reverse record should have no name")
+
+	spoofed := &blockchain.Transaction{Source: spoofer.Hex()}
+	require.NoError(t, enricher.Enrich(context.Background(), spoofed))
+	assert.Empty(t, spoofed.SourceENS, "Unverified reverse records should be ignored")
+}
+
+func TestENSEnricher_CachesLookups(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	fake := newFakeENS(t)
+	source := common.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+	fake.setPrimaryName(source, "vitalik.eth", true)
+
+	enricher, err := NewENSEnricher(logger, fake, 100, time.Hour)
+	require.NoError(t, err)
+
+	tx := &blockchain.Transaction{Source: source.Hex()}
+	require.NoError(t, enricher.Enrich(context.Background(), tx))
+	calls := fake.calls
+
+	again := &blockchain.Transaction{Source: strings.ToLower(source.Hex())}
+	require.NoError(t, enricher.Enrich(context.Background(), again))
+	assert.Equal(t, "vitalik.eth", again.SourceENS)
+	assert.Equal(t, calls, fake.calls, "Cached lookups should not issue RPC calls")
+}
+
+func TestENSEnricher_ErrorsAreNotCached(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	fake := newFakeENS(t)
+	fake.err = errors.New("rpc unavailable")
+
+	enricher, err := NewENSEnricher(logger, fake, 100, time.Hour)
+	require.NoError(t, err)
+
+	tx := &blockchain.Transaction{Source: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"}
+	assert.Error(t, enricher.Enrich(context.Background(), tx))
+
+	fake.err = nil
+	calls := fake.calls
+	require.NoError(t, enricher.Enrich(context.Background(), tx))
+	assert.Greater(t, fake.calls, calls, "Failed lookups should be retried")
+}
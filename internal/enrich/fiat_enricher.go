@@ -0,0 +1,77 @@
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"deblock/internal/blockchain"
+	"deblock/internal/cache"
+	"deblock/internal/pricing"
+)
+
+// weiPerEther converts wei amounts to ether before applying the USD price
+var weiPerEther = new(big.Float).SetInt(big.NewInt(1_000_000_000_000_000_000))
+
+// fiatEnricher attaches the approximate USD value of the transferred amount at block time
+type fiatEnricher struct {
+	logger *slog.Logger
+	feed   pricing.Feed
+	// prices caches the price per block since all transactions of a block share it
+	prices *cache.LRUCache[string, float64]
+	now    func() time.Time
+}
+
+// NewFiatEnricher creates an enricher valuing native transfers through feed
+func NewFiatEnricher(logger *slog.Logger, feed pricing.Feed) *fiatEnricher {
+	return &fiatEnricher{
+		logger: logger,
+		feed:   feed,
+		prices: cache.NewLRUCache[string, float64](64, 0),
+		now:    time.Now,
+	}
+}
+
+// Enrich sets the USD value of tx.Amount
+func (e *fiatEnricher) Enrich(ctx context.Context, tx *blockchain.Transaction) error {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	price, err := e.price(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed to get usd price: %w", err)
+	}
+
+	value := new(big.Float).Quo(new(big.Float).SetInt(tx.Amount), weiPerEther)
+	value.Mul(value, big.NewFloat(price))
+	tx.AmountUSD = value.Text('f', 2)
+	return nil
+}
+
+// price returns the cached or freshly queried price for the block of tx
+func (e *fiatEnricher) price(ctx context.Context, tx *blockchain.Transaction) (float64, error) {
+	at := e.now()
+	if tx.BlockTimestamp > 0 {
+		at = time.Unix(tx.BlockTimestamp, 0)
+	}
+
+	var key string
+	if tx.BlockNumber != nil {
+		key = tx.BlockNumber.String()
+		if price, ok := e.prices.Get(key); ok {
+			return price, nil
+		}
+	}
+
+	price, err := e.feed.USDPrice(ctx, tx.BlockNumber, at)
+	if err != nil {
+		return 0, err
+	}
+	if key != "" {
+		e.prices.Set(key, price)
+	}
+	return price, nil
+}
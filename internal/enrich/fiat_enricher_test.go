@@ -0,0 +1,59 @@
+package enrich
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"deblock/internal/blockchain"
+	"deblock/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFiatEnricher_Enrich(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockFeed := mocks.NewMockFeed(ctrl)
+	blockTime := time.Unix(1_714_560_000, 0)
+
+	// All transactions of a block share one price lookup
+	mockFeed.EXPECT().
+		USDPrice(gomock.Any(), big.NewInt(100), blockTime).
+		Return(3000.0, nil).
+		Times(1)
+
+	enricher := NewFiatEnricher(logger, mockFeed)
+
+	oneAndHalfEther, _ := new(big.Int).SetString("1500000000000000000", 10)
+	tx := &blockchain.Transaction{Amount: oneAndHalfEther, BlockNumber: big.NewInt(100), BlockTimestamp: blockTime.Unix()}
+	require.NoError(t, enricher.Enrich(context.Background(), tx))
+	assert.Equal(t, "4500.00", tx.AmountUSD)
+
+	other := &blockchain.Transaction{Amount: big.NewInt(0), BlockNumber: big.NewInt(100), BlockTimestamp: blockTime.Unix()}
+	require.NoError(t, enricher.Enrich(context.Background(), other))
+	assert.Equal(t, "0.00", other.AmountUSD)
+}
+
+func TestFiatEnricher_FeedError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockFeed := mocks.NewMockFeed(ctrl)
+	mockFeed.EXPECT().USDPrice(gomock.Any(), gomock.Any(), gomock.Any()).Return(0.0, errors.New("rate limited"))
+
+	enricher := NewFiatEnricher(logger, mockFeed)
+
+	tx := &blockchain.Transaction{Amount: big.NewInt(1), BlockNumber: big.NewInt(100)}
+	assert.Error(t, enricher.Enrich(context.Background(), tx))
+	assert.Empty(t, tx.AmountUSD, "Value should be left unset when pricing fails")
+}
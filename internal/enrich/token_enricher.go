@@ -0,0 +1,214 @@
+package enrich
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+
+	"deblock/internal/blockchain"
+	"deblock/internal/cache"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const erc20ABI = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]}
+]`
+
+// tokenMetadata is the cached denomination of a token contract
+type tokenMetadata struct {
+	Symbol   string
+	Decimals uint8
+	// Known is false for contracts not implementing the ERC-20 metadata methods
+	Known bool
+}
+
+// tokenEnricher decodes ERC-20 transfers and attaches human-readable amounts
+type tokenEnricher struct {
+	logger *slog.Logger
+	caller ethereum.ContractCaller
+	abi    abi.ABI
+	cache  *cache.LRUCache[string, tokenMetadata]
+}
+
+// NewTokenEnricher creates an enricher decoding ERC-20 transfer calldata.
+// Symbol and decimals are resolved through caller and cached per contract.
+func NewTokenEnricher(logger *slog.Logger, caller ethereum.ContractCaller, cacheSize int, cacheTTL time.Duration) (*tokenEnricher, error) {
+	parsed, err := abi.JSON(strings.NewReader(erc20ABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse erc20 abi: %w", err)
+	}
+	return &tokenEnricher{
+		logger: logger,
+		caller: caller,
+		abi:    parsed,
+		cache:  cache.NewLRUCache[string, tokenMetadata](cacheSize, cacheTTL),
+	}, nil
+}
+
+// Enrich decodes the token transfer of tx and denominates its amount
+func (e *tokenEnricher) Enrich(ctx context.Context, tx *blockchain.Transaction) error {
+	transfer := e.decodeTransfer(tx)
+	if transfer == nil {
+		return nil
+	}
+	tx.Token = transfer
+
+	metadata, err := e.lookup(ctx, transfer.Contract)
+	if err != nil {
+		return fmt.Errorf("failed to resolve token metadata: %w", err)
+	}
+	if !metadata.Known {
+		return nil
+	}
+	transfer.Symbol = metadata.Symbol
+	transfer.Decimals = metadata.Decimals
+	transfer.Amount = formatUnits(transfer.RawAmount, metadata.Decimals)
+	return nil
+}
+
+// decodeTransfer returns the ERC-20 transfer encoded in the calldata of tx, if any
+func (e *tokenEnricher) decodeTransfer(tx *blockchain.Transaction) *blockchain.TokenTransfer {
+	if tx.InputData == "" || !common.IsHexAddress(tx.Destination) {
+		return nil
+	}
+	data, err := hexutil.Decode(tx.InputData)
+	if err != nil || len(data) < 4 {
+		return nil
+	}
+	method, err := e.abi.MethodById(data[:4])
+	if err != nil || (method.Name != "transfer" && method.Name != "transferFrom") {
+		return nil
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		e.logger.Debug("Failed to unpack token transfer calldata",
+			"txHash", tx.Hash,
+			"error", err,
+		)
+		return nil
+	}
+
+	transfer := &blockchain.TokenTransfer{
+		Contract: tx.Destination,
+		From:     tx.Source,
+	}
+	if method.Name == "transferFrom" {
+		transfer.From = args[0].(common.Address).Hex()
+		args = args[1:]
+	}
+	transfer.To = args[0].(common.Address).Hex()
+	transfer.RawAmount = args[1].(*big.Int)
+	return transfer
+}
+
+// lookup returns the cached or freshly resolved metadata of contract
+func (e *tokenEnricher) lookup(ctx context.Context, contract string) (tokenMetadata, error) {
+	key := strings.ToLower(contract)
+	if metadata, ok := e.cache.Get(key); ok {
+		return metadata, nil
+	}
+
+	metadata, err := e.resolve(ctx, common.HexToAddress(contract))
+	if err != nil {
+		// Errors are not cached so the next transaction retries
+		return tokenMetadata{}, err
+	}
+	e.cache.Set(key, metadata)
+	return metadata, nil
+}
+
+// resolve calls the optional ERC-20 metadata methods of contract
+func (e *tokenEnricher) resolve(ctx context.Context, contract common.Address) (tokenMetadata, error) {
+	decimalsOut, err := e.call(ctx, contract, "decimals")
+	if err != nil {
+		return tokenMetadata{}, err
+	}
+	if len(decimalsOut) < 32 {
+		// Not an ERC-20 token or metadata is not implemented
+		return tokenMetadata{}, nil
+	}
+	values, err := e.abi.Unpack("decimals", decimalsOut)
+	if err != nil {
+		return tokenMetadata{}, nil
+	}
+	decimals, ok := values[0].(uint8)
+	if !ok {
+		return tokenMetadata{}, fmt.Errorf("unexpected decimals result type %T", values[0])
+	}
+
+	symbolOut, err := e.call(ctx, contract, "symbol")
+	if err != nil {
+		return tokenMetadata{}, err
+	}
+	return tokenMetadata{
+		Symbol:   e.unpackSymbol(symbolOut),
+		Decimals: decimals,
+		Known:    true,
+	}, nil
+}
+
+// unpackSymbol decodes a string symbol, falling back to the bytes32 encoding used by early tokens
+func (e *tokenEnricher) unpackSymbol(out []byte) string {
+	if values, err := e.abi.Unpack("symbol", out); err == nil {
+		if symbol, ok := values[0].(string); ok {
+			return symbol
+		}
+	}
+	if len(out) == 32 {
+		return strings.TrimRight(string(out), "\x00")
+	}
+	return ""
+}
+
+func (e *tokenEnricher) call(ctx context.Context, to common.Address, method string) ([]byte, error) {
+	data, err := e.abi.Pack(method)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s call: %w", method, err)
+	}
+	out, err := e.caller.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) || strings.Contains(err.Error(), "execution reverted") {
+			// Reverted calls mean the method is not implemented
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to call %s: %w", method, err)
+	}
+	return out, nil
+}
+
+// formatUnits renders amount as a decimal string shifted by decimals places
+func formatUnits(amount *big.Int, decimals uint8) string {
+	if amount == nil {
+		return ""
+	}
+	if decimals == 0 {
+		return amount.String()
+	}
+
+	base := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	whole, frac := new(big.Int).QuoRem(new(big.Int).Abs(amount), base, new(big.Int))
+
+	result := whole.String()
+	if frac.Sign() != 0 {
+		fracStr := frac.String()
+		fracStr = strings.Repeat("0", int(decimals)-len(fracStr)) + fracStr
+		result += "." + strings.TrimRight(fracStr, "0")
+	}
+	if amount.Sign() < 0 {
+		result = "-" + result
+	}
+	return result
+}
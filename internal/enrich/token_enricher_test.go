@@ -0,0 +1,162 @@
+package enrich
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"deblock/internal/blockchain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTokens answers ERC-20 metadata calls from in-memory records
+type fakeTokens struct {
+	abi      abi.ABI
+	symbols  map[common.Address]string
+	decimals map[common.Address]uint8
+	calls    int
+	err      error
+}
+
+func newFakeTokens(t *testing.T) *fakeTokens {
+	parsed, err := abi.JSON(strings.NewReader(erc20ABI))
+	require.NoError(t, err)
+	return &fakeTokens{
+		abi:      parsed,
+		symbols:  map[common.Address]string{},
+		decimals: map[common.Address]uint8{},
+	}
+}
+
+func (f *fakeTokens) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	decimals, ok := f.decimals[*msg.To]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+	method, err := f.abi.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name == "symbol" {
+		return method.Outputs.Pack(f.symbols[*msg.To])
+	}
+	return method.Outputs.Pack(decimals)
+}
+
+func packTransfer(t *testing.T, to common.Address, amount *big.Int) string {
+	parsed, err := abi.JSON(strings.NewReader(erc20ABI))
+	require.NoError(t, err)
+	data, err := parsed.Pack("transfer", to, amount)
+	require.NoError(t, err)
+	return hexutil.Encode(data)
+}
+
+func TestTokenEnricher_Enrich(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	fake := newFakeTokens(t)
+
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	recipient := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	fake.symbols[usdc] = "USDC"
+	fake.decimals[usdc] = 6
+
+	enricher, err := NewTokenEnricher(logger, fake, 100, time.Hour)
+	require.NoError(t, err)
+
+	tx := &blockchain.Transaction{
+		Source:      "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+		Destination: usdc.Hex(),
+		InputData:   packTransfer(t, recipient, big.NewInt(1_500_000)),
+	}
+	require.NoError(t, enricher.Enrich(context.Background(), tx))
+	require.NotNil(t, tx.Token)
+	assert.Equal(t, usdc.Hex(), tx.Token.Contract)
+	assert.Equal(t, tx.Source, tx.Token.From)
+	assert.Equal(t, recipient.Hex(), tx.Token.To)
+	assert.Equal(t, big.NewInt(1_500_000), tx.Token.RawAmount)
+	assert.Equal(t, "USDC", tx.Token.Symbol)
+	assert.Equal(t, uint8(6), tx.Token.Decimals)
+	assert.Equal(t, "1.5", tx.Token.Amount)
+
+	// Metadata is cached per contract
+	calls := fake.calls
+	require.NoError(t, enricher.Enrich(context.Background(), tx))
+	assert.Equal(t, calls, fake.calls, "Cached metadata should not trigger RPC calls")
+}
+
+func TestTokenEnricher_NotATokenTransfer(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	fake := newFakeTokens(t)
+	enricher, err := NewTokenEnricher(logger, fake, 100, time.Hour)
+	require.NoError(t, err)
+
+	tx := &blockchain.Transaction{Destination: "0x1234567890123456789012345678901234567890", InputData: "0xdeadbeef"}
+	require.NoError(t, enricher.Enrich(context.Background(), tx))
+	assert.Nil(t, tx.Token, "Unknown calldata should not be decoded as a token transfer")
+	assert.Zero(t, fake.calls)
+}
+
+func TestTokenEnricher_MissingMetadata(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	fake := newFakeTokens(t)
+	enricher, err := NewTokenEnricher(logger, fake, 100, time.Hour)
+	require.NoError(t, err)
+
+	recipient := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	tx := &blockchain.Transaction{
+		Destination: "0x0987654321098765432109876543210987654321",
+		InputData:   packTransfer(t, recipient, big.NewInt(42)),
+	}
+	require.NoError(t, enricher.Enrich(context.Background(), tx))
+	require.NotNil(t, tx.Token, "Transfer should be decoded even without metadata")
+	assert.Equal(t, big.NewInt(42), tx.Token.RawAmount)
+	assert.Empty(t, tx.Token.Symbol)
+	assert.Empty(t, tx.Token.Amount)
+}
+
+func TestTokenEnricher_RPCError(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	fake := newFakeTokens(t)
+	fake.err = errors.New("connection refused")
+	enricher, err := NewTokenEnricher(logger, fake, 100, time.Hour)
+	require.NoError(t, err)
+
+	tx := &blockchain.Transaction{
+		Destination: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+		InputData:   packTransfer(t, common.HexToAddress("0x1234567890123456789012345678901234567890"), big.NewInt(1)),
+	}
+	assert.Error(t, enricher.Enrich(context.Background(), tx))
+	assert.NotNil(t, tx.Token, "Decoded transfer should be kept when metadata lookup fails")
+}
+
+func TestFormatUnits(t *testing.T) {
+	tests := []struct {
+		amount   *big.Int
+		decimals uint8
+		expected string
+	}{
+		{big.NewInt(1_500_000), 6, "1.5"},
+		{big.NewInt(1), 18, "0.000000000000000001"},
+		{big.NewInt(2_000_000), 6, "2"},
+		{big.NewInt(42), 0, "42"},
+		{big.NewInt(-150), 2, "-1.5"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, formatUnits(tt.amount, tt.decimals))
+	}
+}
@@ -0,0 +1,9 @@
+package idgen
+
+// Generator creates globally unique identifiers for published events
+//
+//go:generate go run go.uber.org/mock/mockgen@latest -source=idgen.go -destination=../../mocks/mock_idgen.go -package=mocks
+type Generator interface {
+	// NewID returns a new unique identifier
+	NewID() string
+}
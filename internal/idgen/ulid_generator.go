@@ -0,0 +1,34 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid"
+)
+
+// ulidGenerator creates lexicographically sortable ULIDs.
+// IDs generated within the same millisecond are monotonically increasing.
+type ulidGenerator struct {
+	mu      sync.Mutex
+	entropy io.Reader
+	now     func() time.Time
+}
+
+// NewULIDGenerator creates a generator of monotonic ULIDs, safe for concurrent use
+func NewULIDGenerator() *ulidGenerator {
+	return &ulidGenerator{
+		entropy: ulid.Monotonic(rand.Reader, 0),
+		now:     time.Now,
+	}
+}
+
+// NewID returns a new ULID string
+func (g *ulidGenerator) NewID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return ulid.MustNew(ulid.Timestamp(g.now()), g.entropy).String()
+}
@@ -0,0 +1,40 @@
+package idgen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestULIDGenerator_NewID(t *testing.T) {
+	generator := NewULIDGenerator()
+	fixed := time.UnixMilli(1_714_560_000_000)
+	generator.now = func() time.Time { return fixed }
+
+	previous := generator.NewID()
+	for i := 0; i < 100; i++ {
+		id := generator.NewID()
+		assert.Greater(t, id, previous, "IDs within the same millisecond should be increasing")
+		previous = id
+	}
+
+	parsed, err := ulid.Parse(previous)
+	require.NoError(t, err, "ID should be a valid ULID")
+	assert.Equal(t, uint64(fixed.UnixMilli()), parsed.Time())
+}
+
+func TestULIDGenerator_SortableAcrossTime(t *testing.T) {
+	generator := NewULIDGenerator()
+	now := time.UnixMilli(1_714_560_000_000)
+	generator.now = func() time.Time { return now }
+	first := generator.NewID()
+
+	now = now.Add(time.Millisecond)
+	second := generator.NewID()
+
+	assert.Len(t, first, 26)
+	assert.Less(t, first, second, "Later IDs should sort after earlier ones")
+}
@@ -0,0 +1,108 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+)
+
+// ErrMigrationRequired is returned when persisted state is older than this binary
+// expects and automatic upgrades are disabled
+var ErrMigrationRequired = errors.New("persisted state requires migration")
+
+// ErrStateTooNew is returned when persisted state was written by a newer version
+var ErrStateTooNew = errors.New("persisted state is newer than supported")
+
+// Migration upgrades persisted state from Version-1 to Version
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context) error
+}
+
+// VersionStore persists the schema version of the monitor state
+//
+//go:generate go run go.uber.org/mock/mockgen@latest -source=migrate.go -destination=../../mocks/mock_migrate.go -package=mocks
+type VersionStore interface {
+	// CurrentVersion returns the stored schema version, 0 when never stamped
+	CurrentVersion(ctx context.Context) (int, error)
+
+	// SetVersion stores the schema version
+	SetVersion(ctx context.Context, version int) error
+}
+
+// Migrator checks and upgrades persisted state on startup
+type Migrator struct {
+	logger      *slog.Logger
+	store       VersionStore
+	migrations  []Migration
+	autoMigrate bool
+}
+
+// NewMigrator creates a migrator for the given migrations.
+// When autoMigrate is false, pending migrations cause startup to be refused.
+func NewMigrator(logger *slog.Logger, store VersionStore, migrations []Migration, autoMigrate bool) (*Migrator, error) {
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+
+	for i, m := range sorted {
+		if m.Version != i+1 {
+			return nil, fmt.Errorf("migration versions must be contiguous from 1, found %d at position %d", m.Version, i+1)
+		}
+		if m.Up == nil {
+			return nil, fmt.Errorf("migration %d has no Up function", m.Version)
+		}
+	}
+
+	return &Migrator{
+		logger:      logger,
+		store:       store,
+		migrations:  sorted,
+		autoMigrate: autoMigrate,
+	}, nil
+}
+
+// LatestVersion returns the schema version this binary expects
+func (m *Migrator) LatestVersion() int {
+	return len(m.migrations)
+}
+
+// Run verifies compatibility of persisted state and applies pending migrations
+func (m *Migrator) Run(ctx context.Context) error {
+	current, err := m.store.CurrentVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read state schema version: %w", err)
+	}
+
+	latest := m.LatestVersion()
+	switch {
+	case current > latest:
+		return fmt.Errorf("%w: state schema version %d, this binary supports up to %d; upgrade deblock or point it at a fresh state store",
+			ErrStateTooNew, current, latest)
+	case current == latest:
+		m.logger.Info("Persisted state is up to date", "schema_version", current)
+		return nil
+	case !m.autoMigrate:
+		return fmt.Errorf("%w: state schema version %d, expected %d; run `deblock migrate` or set STATE_AUTO_MIGRATE=true",
+			ErrMigrationRequired, current, latest)
+	}
+
+	for _, migration := range m.migrations[current:] {
+		m.logger.Info("Applying state migration",
+			"version", migration.Version,
+			"description", migration.Description,
+		)
+		if err := migration.Up(ctx); err != nil {
+			return fmt.Errorf("state migration %d (%s) failed: %w", migration.Version, migration.Description, err)
+		}
+		if err := m.store.SetVersion(ctx, migration.Version); err != nil {
+			return fmt.Errorf("failed to record state schema version %d: %w", migration.Version, err)
+		}
+	}
+
+	m.logger.Info("Persisted state migrated", "from_version", current, "to_version", latest)
+	return nil
+}
@@ -0,0 +1,135 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"deblock/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testMigrations(applied *[]int) []Migration {
+	up := func(version int) func(context.Context) error {
+		return func(context.Context) error {
+			*applied = append(*applied, version)
+			return nil
+		}
+	}
+	return []Migration{
+		{Version: 2, Description: "second", Up: up(2)},
+		{Version: 1, Description: "first", Up: up(1)},
+	}
+}
+
+func TestMigrator_AppliesPendingMigrationsInOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockStore := mocks.NewMockVersionStore(ctrl)
+
+	var applied []int
+	migrator, err := NewMigrator(logger, mockStore, testMigrations(&applied), true)
+	require.NoError(t, err)
+
+	gomock.InOrder(
+		mockStore.EXPECT().CurrentVersion(gomock.Any()).Return(0, nil),
+		mockStore.EXPECT().SetVersion(gomock.Any(), 1).Return(nil),
+		mockStore.EXPECT().SetVersion(gomock.Any(), 2).Return(nil),
+	)
+
+	err = migrator.Run(context.Background())
+	assert.NoError(t, err, "Run should not return an error")
+	assert.Equal(t, []int{1, 2}, applied)
+}
+
+func TestMigrator_UpToDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockStore := mocks.NewMockVersionStore(ctrl)
+
+	var applied []int
+	migrator, err := NewMigrator(logger, mockStore, testMigrations(&applied), true)
+	require.NoError(t, err)
+
+	mockStore.EXPECT().CurrentVersion(gomock.Any()).Return(2, nil)
+
+	assert.NoError(t, migrator.Run(context.Background()))
+	assert.Empty(t, applied, "No migration should run when state is current")
+}
+
+func TestMigrator_RefusesNewerState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockStore := mocks.NewMockVersionStore(ctrl)
+
+	var applied []int
+	migrator, err := NewMigrator(logger, mockStore, testMigrations(&applied), true)
+	require.NoError(t, err)
+
+	mockStore.EXPECT().CurrentVersion(gomock.Any()).Return(3, nil)
+
+	err = migrator.Run(context.Background())
+	assert.ErrorIs(t, err, ErrStateTooNew)
+}
+
+func TestMigrator_RefusesPendingWithoutAutoMigrate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockStore := mocks.NewMockVersionStore(ctrl)
+
+	var applied []int
+	migrator, err := NewMigrator(logger, mockStore, testMigrations(&applied), false)
+	require.NoError(t, err)
+
+	mockStore.EXPECT().CurrentVersion(gomock.Any()).Return(1, nil)
+
+	err = migrator.Run(context.Background())
+	assert.ErrorIs(t, err, ErrMigrationRequired)
+	assert.Empty(t, applied)
+}
+
+func TestMigrator_StopsOnFailedMigration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockStore := mocks.NewMockVersionStore(ctrl)
+
+	migrations := []Migration{
+		{Version: 1, Description: "broken", Up: func(context.Context) error { return errors.New("boom") }},
+	}
+	migrator, err := NewMigrator(logger, mockStore, migrations, true)
+	require.NoError(t, err)
+
+	mockStore.EXPECT().CurrentVersion(gomock.Any()).Return(0, nil)
+	mockStore.EXPECT().SetVersion(gomock.Any(), gomock.Any()).Times(0)
+
+	assert.Error(t, migrator.Run(context.Background()))
+}
+
+func TestNewMigrator_RejectsGaps(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	noop := func(context.Context) error { return nil }
+
+	_, err := NewMigrator(logger, nil, []Migration{{Version: 1, Up: noop}, {Version: 3, Up: noop}}, true)
+	assert.Error(t, err, "Gaps in migration versions should be rejected")
+}
+
+func TestMigrations_AreValid(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	_, err := NewMigrator(logger, nil, Migrations(), true)
+	assert.NoError(t, err, "Registered migrations should be contiguous")
+}
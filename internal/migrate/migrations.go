@@ -0,0 +1,15 @@
+package migrate
+
+import "context"
+
+// Migrations lists all state migrations in order.
+// Append a new entry whenever the layout of persisted keys or tables changes.
+func Migrations() []Migration {
+	return []Migration{
+		{
+			Version:     1,
+			Description: "stamp initial state schema version",
+			Up:          func(context.Context) error { return nil },
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+// VersionKey is the Redis key holding the state schema version
+const VersionKey = "deblock:state:schema_version"
+
+// redisVersionStore implements VersionStore on top of Redis
+type redisVersionStore struct {
+	client goredislib.UniversalClient
+}
+
+// NewRedisVersionStore creates a VersionStore backed by Redis
+func NewRedisVersionStore(client goredislib.UniversalClient) *redisVersionStore {
+	return &redisVersionStore{client: client}
+}
+
+func (s *redisVersionStore) CurrentVersion(ctx context.Context) (int, error) {
+	version, err := s.client.Get(ctx, VersionKey).Int()
+	if errors.Is(err, goredislib.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	return version, nil
+}
+
+func (s *redisVersionStore) SetVersion(ctx context.Context, version int) error {
+	if err := s.client.Set(ctx, VersionKey, version, 0).Err(); err != nil {
+		return fmt.Errorf("failed to set schema version: %w", err)
+	}
+	return nil
+}
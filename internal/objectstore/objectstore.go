@@ -0,0 +1,11 @@
+package objectstore
+
+import "context"
+
+// Store defines the interface for writing payloads to object storage
+//
+//go:generate go run go.uber.org/mock/mockgen@latest -source=objectstore.go -destination=../../mocks/mock_objectstore.go -package=mocks
+type Store interface {
+	// Put writes data under key and returns a URL pointing to the stored object
+	Put(ctx context.Context, key string, contentType string, data []byte) (string, error)
+}
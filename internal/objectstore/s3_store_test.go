@@ -0,0 +1,69 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3Store_Put(t *testing.T) {
+	var (
+		gotPath string
+		gotBody []byte
+		gotAuth string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store, err := NewS3Store(S3Config{
+		Endpoint:  server.URL,
+		Bucket:    "events",
+		Region:    "eu-west-1",
+		AccessKey: "AKID",
+		SecretKey: "secret",
+	}, server.Client())
+	require.NoError(t, err)
+	store.now = func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	url, err := store.Put(context.Background(), "transaction/tx1hash.json", "application/json", []byte("payload"))
+	require.NoError(t, err, "Put should not return an error")
+
+	assert.Equal(t, server.URL+"/events/transaction/tx1hash.json", url)
+	assert.Equal(t, "/events/transaction/tx1hash.json", gotPath)
+	assert.Equal(t, []byte("payload"), gotBody)
+	assert.True(t, strings.HasPrefix(gotAuth, "AWS4-HMAC-SHA256 Credential=AKID/20240102/eu-west-1/s3/aws4_request"),
+		"Request should carry a SigV4 authorization header")
+}
+
+func TestS3Store_PutErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	store, err := NewS3Store(S3Config{Endpoint: server.URL, Bucket: "events"}, server.Client())
+	require.NoError(t, err)
+
+	_, err = store.Put(context.Background(), "key.json", "application/json", []byte("payload"))
+	assert.ErrorContains(t, err, "403")
+}
+
+func TestNewS3Store_Validation(t *testing.T) {
+	_, err := NewS3Store(S3Config{Endpoint: "http://localhost:9000"}, nil)
+	assert.Error(t, err, "Bucket should be required")
+
+	_, err = NewS3Store(S3Config{Endpoint: "not a url", Bucket: "events"}, nil)
+	assert.Error(t, err, "Endpoint should be validated")
+}
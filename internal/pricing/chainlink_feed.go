@@ -0,0 +1,109 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ChainlinkETHUSDFeed is the Chainlink ETH/USD aggregator proxy on Ethereum mainnet
+const ChainlinkETHUSDFeed = "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419"
+
+const aggregatorABI = `[
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"latestRoundData","stateMutability":"view","inputs":[],"outputs":[{"name":"roundId","type":"uint80"},{"name":"answer","type":"int256"},{"name":"startedAt","type":"uint256"},{"name":"updatedAt","type":"uint256"},{"name":"answeredInRound","type":"uint80"}]}
+]`
+
+// chainlinkFeed implements Feed by reading a Chainlink aggregator at the block of the transaction
+type chainlinkFeed struct {
+	caller     ethereum.ContractCaller
+	aggregator common.Address
+	abi        abi.ABI
+
+	mu       sync.Mutex
+	decimals *uint8
+}
+
+// NewChainlinkFeed creates a feed reading the aggregator proxy at address.
+// Historical reads require the RPC node to serve state for past blocks.
+func NewChainlinkFeed(caller ethereum.ContractCaller, address string) (*chainlinkFeed, error) {
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("invalid chainlink feed address %q", address)
+	}
+	parsed, err := abi.JSON(strings.NewReader(aggregatorABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse aggregator abi: %w", err)
+	}
+	return &chainlinkFeed{
+		caller:     caller,
+		aggregator: common.HexToAddress(address),
+		abi:        parsed,
+	}, nil
+}
+
+// USDPrice returns the latest answer of the aggregator as of blockNumber
+func (f *chainlinkFeed) USDPrice(ctx context.Context, blockNumber *big.Int, _ time.Time) (float64, error) {
+	decimals, err := f.feedDecimals(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	values, err := f.call(ctx, "latestRoundData", blockNumber)
+	if err != nil {
+		return 0, err
+	}
+	answer, ok := values[1].(*big.Int)
+	if !ok {
+		return 0, fmt.Errorf("unexpected latestRoundData answer type %T", values[1])
+	}
+	if answer.Sign() <= 0 {
+		return 0, fmt.Errorf("chainlink feed returned non-positive answer %s", answer)
+	}
+
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	price, _ := new(big.Float).Quo(new(big.Float).SetInt(answer), scale).Float64()
+	return price, nil
+}
+
+// feedDecimals returns the aggregator decimals, which never change for a feed
+func (f *chainlinkFeed) feedDecimals(ctx context.Context) (uint8, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.decimals != nil {
+		return *f.decimals, nil
+	}
+	values, err := f.call(ctx, "decimals", nil)
+	if err != nil {
+		return 0, err
+	}
+	decimals, ok := values[0].(uint8)
+	if !ok {
+		return 0, fmt.Errorf("unexpected decimals result type %T", values[0])
+	}
+	f.decimals = &decimals
+	return decimals, nil
+}
+
+func (f *chainlinkFeed) call(ctx context.Context, method string, blockNumber *big.Int) ([]interface{}, error) {
+	data, err := f.abi.Pack(method)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s call: %w", method, err)
+	}
+	out, err := f.caller.CallContract(ctx, ethereum.CallMsg{To: &f.aggregator, Data: data}, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", method, err)
+	}
+	values, err := f.abi.Unpack(method, out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s result: %w", method, err)
+	}
+	return values, nil
+}
@@ -0,0 +1,73 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAggregator answers aggregator calls with fixed answers per block
+type fakeAggregator struct {
+	abi           abi.ABI
+	answers       map[int64]*big.Int
+	decimalsCalls int
+	blocks        []*big.Int
+}
+
+func (f *fakeAggregator) CallContract(_ context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	method, err := f.abi.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name == "decimals" {
+		f.decimalsCalls++
+		return method.Outputs.Pack(uint8(8))
+	}
+	f.blocks = append(f.blocks, blockNumber)
+	answer, ok := f.answers[blockNumber.Int64()]
+	if !ok {
+		return nil, errors.New("missing trie node")
+	}
+	return method.Outputs.Pack(big.NewInt(1), answer, big.NewInt(0), big.NewInt(0), big.NewInt(1))
+}
+
+func TestChainlinkFeed_USDPrice(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(aggregatorABI))
+	require.NoError(t, err)
+	fake := &fakeAggregator{
+		abi: parsed,
+		answers: map[int64]*big.Int{
+			100: big.NewInt(312_345_000_000),
+			101: big.NewInt(-1),
+		},
+	}
+
+	feed, err := NewChainlinkFeed(fake, ChainlinkETHUSDFeed)
+	require.NoError(t, err)
+
+	price, err := feed.USDPrice(context.Background(), big.NewInt(100), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 3123.45, price)
+	assert.Equal(t, big.NewInt(100), fake.blocks[0], "Aggregator should be read at the transaction block")
+
+	_, err = feed.USDPrice(context.Background(), big.NewInt(101), time.Now())
+	assert.ErrorContains(t, err, "non-positive")
+
+	_, err = feed.USDPrice(context.Background(), big.NewInt(102), time.Now())
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, fake.decimalsCalls, "Feed decimals should be read once")
+}
+
+func TestNewChainlinkFeed_InvalidAddress(t *testing.T) {
+	_, err := NewChainlinkFeed(nil, "not-an-address")
+	assert.Error(t, err)
+}
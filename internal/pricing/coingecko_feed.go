@@ -0,0 +1,114 @@
+package pricing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"deblock/internal/cache"
+)
+
+// DefaultCoinGeckoURL is the public CoinGecko API
+const DefaultCoinGeckoURL = "https://api.coingecko.com/api/v3"
+
+// coinGeckoBucket is the granularity at which historical prices are fetched and cached
+const coinGeckoBucket = time.Hour
+
+// coinGeckoFeed implements Feed using the CoinGecko market chart range API
+type coinGeckoFeed struct {
+	baseURL    string
+	apiKey     string
+	coinID     string
+	httpClient *http.Client
+	cache      *cache.LRUCache[int64, [][2]float64]
+}
+
+// NewCoinGeckoFeed creates a feed for coinID (e.g. "ethereum").
+// Price points are fetched per hour and cached briefly so recent hours are refreshed.
+func NewCoinGeckoFeed(baseURL, apiKey, coinID string, httpClient *http.Client) (*coinGeckoFeed, error) {
+	if coinID == "" {
+		return nil, fmt.Errorf("coingecko coin id is required")
+	}
+	if baseURL == "" {
+		baseURL = DefaultCoinGeckoURL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &coinGeckoFeed{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		coinID:     coinID,
+		httpClient: httpClient,
+		cache:      cache.NewLRUCache[int64, [][2]float64](48, 5*time.Minute),
+	}, nil
+}
+
+// USDPrice returns the price point closest to at
+func (f *coinGeckoFeed) USDPrice(ctx context.Context, _ *big.Int, at time.Time) (float64, error) {
+	bucket := at.Truncate(coinGeckoBucket).Unix()
+	prices, ok := f.cache.Get(bucket)
+	if !ok {
+		var err error
+		prices, err = f.fetchRange(ctx, at.Truncate(coinGeckoBucket))
+		if err != nil {
+			return 0, err
+		}
+		f.cache.Set(bucket, prices)
+	}
+
+	target := float64(at.UnixMilli())
+	closest, found := 0.0, false
+	bestDistance := math.Inf(1)
+	for _, point := range prices {
+		if distance := math.Abs(point[0] - target); distance < bestDistance {
+			bestDistance, closest, found = distance, point[1], true
+		}
+	}
+	if !found {
+		return 0, fmt.Errorf("no coingecko price available for %s at %s", f.coinID, at.UTC().Format(time.RFC3339))
+	}
+	return closest, nil
+}
+
+// fetchRange loads price points around the hour starting at start
+func (f *coinGeckoFeed) fetchRange(ctx context.Context, start time.Time) ([][2]float64, error) {
+	query := url.Values{}
+	query.Set("vs_currency", "usd")
+	query.Set("from", fmt.Sprint(start.Add(-coinGeckoBucket).Unix()))
+	query.Set("to", fmt.Sprint(start.Add(2*coinGeckoBucket).Unix()))
+	endpoint := fmt.Sprintf("%s/coins/%s/market_chart/range?%s", f.baseURL, url.PathEscape(f.coinID), query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create coingecko request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if f.apiKey != "" {
+		req.Header.Set("x-cg-demo-api-key", f.apiKey)
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query coingecko: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("coingecko returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Prices [][2]float64 `json:"prices"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode coingecko response: %w", err)
+	}
+	return body.Prices, nil
+}
@@ -0,0 +1,67 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinGeckoFeed_USDPrice(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 20, 0, 0, time.UTC)
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/coins/ethereum/market_chart/range", r.URL.Path)
+		assert.Equal(t, "usd", r.URL.Query().Get("vs_currency"))
+		assert.Equal(t, "demo-key", r.Header.Get("x-cg-demo-api-key"))
+
+		fmt.Fprintf(w, `{"prices":[[%d,3000.5],[%d,3010.25],[%d,3020]]}`,
+			at.Add(-20*time.Minute).UnixMilli(),
+			at.Add(3*time.Minute).UnixMilli(),
+			at.Add(30*time.Minute).UnixMilli(),
+		)
+	}))
+	defer server.Close()
+
+	feed, err := NewCoinGeckoFeed(server.URL, "demo-key", "ethereum", nil)
+	require.NoError(t, err)
+
+	price, err := feed.USDPrice(context.Background(), nil, at)
+	require.NoError(t, err)
+	assert.Equal(t, 3010.25, price, "Closest price point should be used")
+
+	// Prices of the same hour are served from cache
+	_, err = feed.USDPrice(context.Background(), nil, at.Add(10*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestCoinGeckoFeed_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("from") == "0" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"prices":[]}`)
+	}))
+	defer server.Close()
+
+	feed, err := NewCoinGeckoFeed(server.URL, "", "ethereum", nil)
+	require.NoError(t, err)
+
+	_, err = feed.USDPrice(context.Background(), nil, time.Unix(3600, 0))
+	assert.ErrorContains(t, err, "status 429")
+
+	_, err = feed.USDPrice(context.Background(), nil, time.Now())
+	assert.ErrorContains(t, err, "no coingecko price")
+
+	_, err = NewCoinGeckoFeed(server.URL, "", "", nil)
+	assert.Error(t, err, "Coin id should be required")
+}
@@ -0,0 +1,16 @@
+package pricing
+
+import (
+	"context"
+	"math/big"
+	"time"
+)
+
+// Feed provides the USD price of the chain's native asset
+//
+//go:generate go run go.uber.org/mock/mockgen@latest -source=pricing.go -destination=../../mocks/mock_pricing.go -package=mocks
+type Feed interface {
+	// USDPrice returns the approximate price at the given block and block time.
+	// Backends use whichever of the two they can query historically.
+	USDPrice(ctx context.Context, blockNumber *big.Int, at time.Time) (float64, error)
+}
@@ -0,0 +1,22 @@
+package pubsub
+
+import "context"
+
+type contextKey int
+
+const eventIDKey contextKey = iota
+
+// MetadataEventID is the message metadata key carrying the event ID
+const MetadataEventID = "event_id"
+
+// WithEventID returns a context carrying the ID of the event about to be published.
+// Publishers use it as the message ID and expose it in the message metadata.
+func WithEventID(ctx context.Context, eventID string) context.Context {
+	return context.WithValue(ctx, eventIDKey, eventID)
+}
+
+// EventIDFromContext returns the event ID stored in ctx, or an empty string
+func EventIDFromContext(ctx context.Context) string {
+	eventID, _ := ctx.Value(eventIDKey).(string)
+	return eventID
+}
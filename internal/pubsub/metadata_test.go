@@ -0,0 +1,16 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventIDContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, EventIDFromContext(ctx), "Context without event ID should return empty string")
+
+	ctx = WithEventID(ctx, "01HWZ5Y6N0000000000000000")
+	assert.Equal(t, "01HWZ5Y6N0000000000000000", EventIDFromContext(ctx))
+}
@@ -0,0 +1,104 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"deblock/internal/objectstore"
+
+	"github.com/ThreeDotsLabs/watermill"
+)
+
+// OverflowTransaction is the compact event published in place of an oversized payload.
+// The full payload can be fetched from PayloadURL.
+type OverflowTransaction struct {
+	EventID     string
+	Source      string
+	Destination string
+	Amount      *big.Int
+	Fees        *big.Int
+	Hash        string
+	Status      string
+	MethodID    string
+	MethodName  string
+	PayloadURL  string
+	PayloadSize int
+}
+
+// overflowPublisher offloads payloads above maxBytes to object storage
+type overflowPublisher struct {
+	logger   *slog.Logger
+	next     Publisher
+	store    objectstore.Store
+	maxBytes int
+}
+
+// NewOverflowPublisher wraps a Publisher so that messages larger than maxBytes are written
+// to the object store and replaced by a compact pointer event
+func NewOverflowPublisher(logger *slog.Logger, next Publisher, store objectstore.Store, maxBytes int) *overflowPublisher {
+	return &overflowPublisher{
+		logger:   logger,
+		next:     next,
+		store:    store,
+		maxBytes: maxBytes,
+	}
+}
+
+func (p *overflowPublisher) Publish(ctx context.Context, topic string, msg []byte) error {
+	if p.maxBytes <= 0 || len(msg) <= p.maxBytes {
+		return p.next.Publish(ctx, topic, msg)
+	}
+
+	// Keep the essential fields so consumers can act without fetching the payload
+	var essential Transaction
+	if err := json.Unmarshal(msg, &essential); err != nil {
+		p.logger.Warn("Oversized payload is not a transaction event, publishing pointer only", "error", err)
+	}
+
+	id := essential.EventID
+	if id == "" {
+		id = essential.Hash
+	}
+	if id == "" {
+		id = watermill.NewUUID()
+	}
+	key := fmt.Sprintf("%s/%s.json", topic, id)
+
+	payloadURL, err := p.store.Put(ctx, key, "application/json", msg)
+	if err != nil {
+		return fmt.Errorf("failed to offload oversized payload: %w", err)
+	}
+
+	p.logger.Info("Offloaded oversized payload to object storage",
+		"topic", topic,
+		"size", len(msg),
+		"max_bytes", p.maxBytes,
+		"url", payloadURL,
+	)
+
+	compact, err := json.Marshal(&OverflowTransaction{
+		EventID:     essential.EventID,
+		Source:      essential.Source,
+		Destination: essential.Destination,
+		Amount:      essential.Amount,
+		Fees:        essential.Fees,
+		Hash:        essential.Hash,
+		Status:      essential.Status,
+		MethodID:    essential.MethodID,
+		MethodName:  essential.MethodName,
+		PayloadURL:  payloadURL,
+		PayloadSize: len(msg),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal overflow event: %w", err)
+	}
+
+	return p.next.Publish(ctx, topic, compact)
+}
+
+func (p *overflowPublisher) Close(ctx context.Context) error {
+	return p.next.Close(ctx)
+}
@@ -0,0 +1,93 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math/big"
+	"os"
+	"testing"
+
+	"deblock/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOverflowPublisher_SmallPayloadPassesThrough(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockPublisher := mocks.NewMockPublisher(ctrl)
+	mockStore := mocks.NewMockStore(ctrl)
+
+	msg := []byte(`{"Hash":"tx1hash"}`)
+	mockPublisher.EXPECT().Publish(gomock.Any(), TopicTransaction, msg).Return(nil)
+	mockStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	publisher := NewOverflowPublisher(logger, mockPublisher, mockStore, 1024)
+	err := publisher.Publish(context.Background(), TopicTransaction, msg)
+	assert.NoError(t, err, "Publish should not return an error")
+}
+
+func TestOverflowPublisher_OversizedPayloadIsOffloaded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockPublisher := mocks.NewMockPublisher(ctrl)
+	mockStore := mocks.NewMockStore(ctrl)
+
+	msg, err := json.Marshal(&Transaction{
+		Source:      "0x1234",
+		Destination: "0x5678",
+		Amount:      big.NewInt(100),
+		Fees:        big.NewInt(10),
+		Hash:        "tx1hash",
+	})
+	require.NoError(t, err)
+
+	payloadURL := "https://storage.example.com/events/transaction/tx1hash.json"
+	mockStore.EXPECT().
+		Put(gomock.Any(), "transaction/tx1hash.json", "application/json", msg).
+		Return(payloadURL, nil)
+
+	var published []byte
+	mockPublisher.EXPECT().
+		Publish(gomock.Any(), TopicTransaction, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, m []byte) error {
+			published = m
+			return nil
+		})
+
+	publisher := NewOverflowPublisher(logger, mockPublisher, mockStore, 10)
+	err = publisher.Publish(context.Background(), TopicTransaction, msg)
+	require.NoError(t, err, "Publish should not return an error")
+
+	var compact OverflowTransaction
+	require.NoError(t, json.Unmarshal(published, &compact))
+	assert.Equal(t, "tx1hash", compact.Hash)
+	assert.Equal(t, "0x5678", compact.Destination)
+	assert.Equal(t, big.NewInt(100), compact.Amount)
+	assert.Equal(t, payloadURL, compact.PayloadURL)
+	assert.Equal(t, len(msg), compact.PayloadSize)
+}
+
+func TestOverflowPublisher_StoreError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockPublisher := mocks.NewMockPublisher(ctrl)
+	mockStore := mocks.NewMockStore(ctrl)
+
+	mockStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("upload failed"))
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	publisher := NewOverflowPublisher(logger, mockPublisher, mockStore, 1)
+	err := publisher.Publish(context.Background(), TopicTransaction, []byte(`{"Hash":"tx1hash"}`))
+	assert.Error(t, err, "Publish should fail when the payload cannot be offloaded")
+}
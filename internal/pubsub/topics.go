@@ -2,4 +2,5 @@ package pubsub
 
 const (
 	TopicTransaction = "transaction"
+	TopicControl     = "control"
 )
@@ -4,9 +4,30 @@ import "math/big"
 
 // Transaction represents a generic blockchain transaction
 type Transaction struct {
-	Source      string
-	Destination string
-	Amount      *big.Int
-	Fees        *big.Int
-	Hash        string
+	// EventID is a unique, time-sortable ID of this event (ULID by default)
+	EventID        string
+	Source         string
+	Destination    string
+	Amount         *big.Int
+	Fees           *big.Int
+	Hash           string
+	Status         string
+	InputData      string
+	MethodID       string
+	MethodName     string
+	SourceENS      string
+	DestinationENS string
+	Token          *TokenTransfer
+	AmountUSD      string
+}
+
+// TokenTransfer represents an ERC-20 token movement with a human-readable amount
+type TokenTransfer struct {
+	Contract  string
+	From      string
+	To        string
+	RawAmount *big.Int
+	Symbol    string
+	Decimals  uint8
+	Amount    string
 }
@@ -0,0 +1,18 @@
+package pubsub
+
+import "time"
+
+const (
+	EventAddressWatchAdded   = "address_watch_added"
+	EventAddressWatchRemoved = "address_watch_removed"
+)
+
+// WatchChangeEvent is published to the control topic whenever the watch list changes
+type WatchChangeEvent struct {
+	EventID   string
+	Type      string
+	Addresses []string
+	Actor     string
+	Tenant    string
+	Timestamp time.Time
+}
@@ -33,7 +33,16 @@ func NewKafkaWatermillPublisher(logger *slog.Logger, brokers []string) (*kafkaWa
 }
 
 func (p *kafkaWatermillPublisher) Publish(ctx context.Context, topic string, msg []byte) error {
-	watermillMsg := message.NewMessage(watermill.NewUUID(), msg)
+	// Reuse the event ID as message ID so consumers can correlate both
+	messageID := watermill.NewUUID()
+	eventID := EventIDFromContext(ctx)
+	if eventID != "" {
+		messageID = eventID
+	}
+	watermillMsg := message.NewMessage(messageID, msg)
+	if eventID != "" {
+		watermillMsg.Metadata.Set(MetadataEventID, eventID)
+	}
 	return p.kafkaPublisher.Publish(topic, watermillMsg)
 }
 
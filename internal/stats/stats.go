@@ -0,0 +1,15 @@
+package stats
+
+import "time"
+
+// CandidateStats summarizes watch-only matches for candidate addresses.
+// It is used to size event volumes before candidates are actually watched.
+type CandidateStats struct {
+	Enabled            bool              `json:"enabled"`
+	CandidateAddresses int               `json:"candidate_addresses"`
+	BlocksScanned      uint64            `json:"blocks_scanned"`
+	MatchedTxCount     uint64            `json:"matched_tx_count"`
+	EstimatedBytes     uint64            `json:"estimated_bytes"`
+	MatchesByAddress   map[string]uint64 `json:"matches_by_address"`
+	Since              time.Time         `json:"since"`
+}
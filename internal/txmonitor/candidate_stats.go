@@ -0,0 +1,87 @@
+package txmonitor
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"deblock/internal/blockchain"
+	"deblock/internal/stats"
+)
+
+// candidateStats accumulates watch-only match counters
+type candidateStats struct {
+	mu               sync.Mutex
+	blocksScanned    uint64
+	matchedTxCount   uint64
+	estimatedBytes   uint64
+	matchesByAddress map[string]uint64
+	since            time.Time
+}
+
+func newCandidateStats() *candidateStats {
+	return &candidateStats{
+		matchesByAddress: make(map[string]uint64),
+		since:            time.Now().UTC(),
+	}
+}
+
+// recordCandidateMatches counts candidate matches in a block without publishing anything
+func (m *txMonitorService) recordCandidateMatches(ctx context.Context, block blockchain.Block) {
+	if m.candidateWatcher == nil {
+		return
+	}
+
+	s := m.candidateStats
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blocksScanned++
+	for _, tx := range block.Transactions {
+		sourceMatched := m.candidateWatcher.IsWatched(ctx, tx.Source)
+		destinationMatched := m.candidateWatcher.IsWatched(ctx, tx.Destination)
+		if !sourceMatched && !destinationMatched {
+			continue
+		}
+
+		s.matchedTxCount++
+		if sourceMatched {
+			s.matchesByAddress[tx.Source]++
+		}
+		if destinationMatched {
+			s.matchesByAddress[tx.Destination]++
+		}
+
+		// Estimate the size of the event that would have been published
+		if msg, err := json.Marshal(newTransactionEvent(tx)); err == nil {
+			s.estimatedBytes += uint64(len(msg))
+		}
+	}
+}
+
+// CandidateStats returns a snapshot of the watch-only candidate statistics
+func (m *txMonitorService) CandidateStats(ctx context.Context) stats.CandidateStats {
+	if m.candidateWatcher == nil {
+		return stats.CandidateStats{MatchesByAddress: map[string]uint64{}}
+	}
+
+	s := m.candidateStats
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matches := make(map[string]uint64, len(s.matchesByAddress))
+	for addr, count := range s.matchesByAddress {
+		matches[addr] = count
+	}
+
+	return stats.CandidateStats{
+		Enabled:            true,
+		CandidateAddresses: len(m.candidateWatcher.GetWatchedAddresses(ctx)),
+		BlocksScanned:      s.blocksScanned,
+		MatchedTxCount:     s.matchedTxCount,
+		EstimatedBytes:     s.estimatedBytes,
+		MatchesByAddress:   matches,
+		Since:              s.since,
+	}
+}
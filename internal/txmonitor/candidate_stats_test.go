@@ -0,0 +1,94 @@
+package txmonitor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"testing"
+
+	"deblock/internal/address"
+	"deblock/internal/blockchain"
+	"deblock/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTxMonitorService_CandidateStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockBlockchainClient := mocks.NewMockClient(ctrl)
+	mockAddressWatcher := mocks.NewMockWatcher(ctrl)
+	mockPublisher := mocks.NewMockPublisher(ctrl)
+	mockDlock := mocks.NewMockDistributedLock(ctrl)
+
+	ctx := context.Background()
+	candidateAddr := "0xcandidate"
+	candidateWatcher := address.NewInMemoryAddressWatcher()
+	candidateWatcher.AddAddresses(ctx, []string{candidateAddr})
+
+	service := NewTxMonitorService(logger, mockBlockchainClient, mockAddressWatcher, mockPublisher, mockDlock,
+		WithCandidateWatcher(candidateWatcher),
+	).(*txMonitorService)
+
+	block := blockchain.Block{
+		Number: big.NewInt(100),
+		Hash:   "block123",
+		Transactions: []blockchain.Transaction{
+			{
+				Source:      "0x1234",
+				Destination: candidateAddr,
+				Amount:      big.NewInt(100),
+				Fees:        big.NewInt(10),
+				Hash:        "tx1hash",
+			},
+			{
+				Source:      "0x1234",
+				Destination: "0x5678",
+				Amount:      big.NewInt(200),
+				Fees:        big.NewInt(20),
+				Hash:        "tx2hash",
+			},
+		},
+	}
+
+	lockKey := fmt.Sprintf("block_lock_%s", block.Hash)
+	mockDlock.EXPECT().Lock(gomock.Any(), lockKey).Return(nil)
+	mockDlock.EXPECT().Unlock(gomock.Any(), lockKey).Return(true, nil)
+
+	// Nothing is watched for real, so nothing must be published
+	mockAddressWatcher.EXPECT().IsWatched(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := service.processBlock(ctx, block)
+	assert.NoError(t, err, "processBlock should not return an error")
+
+	stats := service.CandidateStats(ctx)
+	assert.True(t, stats.Enabled, "Watch-only mode should be enabled")
+	assert.Equal(t, 1, stats.CandidateAddresses)
+	assert.Equal(t, uint64(1), stats.BlocksScanned)
+	assert.Equal(t, uint64(1), stats.MatchedTxCount)
+	assert.Equal(t, uint64(1), stats.MatchesByAddress[candidateAddr])
+	assert.Positive(t, stats.EstimatedBytes, "Estimated event bytes should be tracked")
+}
+
+func TestTxMonitorService_CandidateStats_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	service := NewTxMonitorService(logger,
+		mocks.NewMockClient(ctrl),
+		mocks.NewMockWatcher(ctrl),
+		mocks.NewMockPublisher(ctrl),
+		mocks.NewMockDistributedLock(ctrl),
+	)
+
+	stats := service.CandidateStats(context.Background())
+	assert.False(t, stats.Enabled, "Watch-only mode should be disabled without candidates")
+	assert.Zero(t, stats.MatchedTxCount)
+}
@@ -0,0 +1,39 @@
+package txmonitor
+
+import (
+	"deblock/internal/address"
+	"deblock/internal/enrich"
+	"deblock/internal/idgen"
+)
+
+// Option allows configuring optional transaction monitor behavior
+type Option func(*txMonitorService)
+
+// WithCandidateWatcher enables watch-only mode for the given candidate addresses.
+// Matches against the candidate watcher are counted but never published.
+func WithCandidateWatcher(watcher address.Watcher) Option {
+	return func(m *txMonitorService) {
+		m.candidateWatcher = watcher
+	}
+}
+
+// WithSkipFailedTransactions drops relevant transactions whose receipt reports a failure
+func WithSkipFailedTransactions(skip bool) Option {
+	return func(m *txMonitorService) {
+		m.skipFailedTxs = skip
+	}
+}
+
+// WithEnrichers attaches extra data to each relevant transaction before it is published
+func WithEnrichers(enrichers ...enrich.Enricher) Option {
+	return func(m *txMonitorService) {
+		m.enrichers = append(m.enrichers, enrichers...)
+	}
+}
+
+// WithIDGenerator replaces the ULID generator used to assign event IDs
+func WithIDGenerator(generator idgen.Generator) Option {
+	return func(m *txMonitorService) {
+		m.idGenerator = generator
+	}
+}
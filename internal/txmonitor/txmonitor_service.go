@@ -11,7 +11,10 @@ import (
 	"deblock/internal/address"
 	"deblock/internal/blockchain"
 	"deblock/internal/dlock"
+	"deblock/internal/enrich"
+	"deblock/internal/idgen"
 	"deblock/internal/pubsub"
+	"deblock/internal/stats"
 )
 
 //go:generate go run go.uber.org/mock/mockgen@latest -source=txmonitor_service.go -destination=../../mocks/mock_txmonitor_service.go -package=mocks
@@ -19,6 +22,7 @@ type TxMonitorService interface {
 	Start(ctx context.Context) error
 	Stop(ctx context.Context) error
 	IsRunning(ctx context.Context) bool
+	CandidateStats(ctx context.Context) stats.CandidateStats
 }
 
 type txMonitorService struct {
@@ -28,14 +32,20 @@ type txMonitorService struct {
 	publisher        pubsub.Publisher
 	dlock            dlock.DistributedLock
 
+	candidateWatcher address.Watcher
+	candidateStats   *candidateStats
+	skipFailedTxs    bool
+	enrichers        []enrich.Enricher
+	idGenerator      idgen.Generator
+
 	mu         sync.RWMutex
 	cancelFunc context.CancelFunc
 	wg         sync.WaitGroup
 	isRunning  bool
 }
 
-func NewTxMonitorService(logger *slog.Logger, blockchainClient blockchain.Client, addressWatcher address.Watcher, publisher pubsub.Publisher, dlock dlock.DistributedLock) TxMonitorService {
-	return &txMonitorService{
+func NewTxMonitorService(logger *slog.Logger, blockchainClient blockchain.Client, addressWatcher address.Watcher, publisher pubsub.Publisher, dlock dlock.DistributedLock, opts ...Option) TxMonitorService {
+	m := &txMonitorService{
 		logger:           logger,
 		blockchainClient: blockchainClient,
 		addressWatcher:   addressWatcher,
@@ -45,7 +55,13 @@ func NewTxMonitorService(logger *slog.Logger, blockchainClient blockchain.Client
 		cancelFunc:       nil,
 		wg:               sync.WaitGroup{},
 		isRunning:        false,
+		candidateStats:   newCandidateStats(),
+		idGenerator:      idgen.NewULIDGenerator(),
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
 // Start begins monitoring blockchain transactions
@@ -130,6 +146,9 @@ func (m *txMonitorService) processBlock(ctx context.Context, block blockchain.Bl
 	}
 	defer m.dlock.Unlock(ctx, lockKey)
 
+	// Count watch-only candidate matches; these are never published
+	m.recordCandidateMatches(ctx, block)
+
 	relevantTxCount := 0
 	for _, tx := range block.Transactions {
 		// Check if transaction involves watched addresses
@@ -137,16 +156,20 @@ func (m *txMonitorService) processBlock(ctx context.Context, block blockchain.Bl
 			continue
 		}
 
+		// Optionally drop reverted transactions since no value moved
+		if m.skipFailedTxs && tx.Status == blockchain.TransactionStatusFailed {
+			m.logger.Debug("Skipping failed transaction", "hash", tx.Hash)
+			continue
+		}
+
 		relevantTxCount++
 
+		// Enrichment is best effort and never blocks publishing
+		m.enrichTransaction(ctx, &tx)
+
 		// Create Kafka event
-		event := &pubsub.Transaction{
-			Source:      tx.Source,
-			Destination: tx.Destination,
-			Amount:      tx.Amount,
-			Fees:        tx.Fees,
-			Hash:        tx.Hash,
-		}
+		event := newTransactionEvent(tx)
+		event.EventID = m.idGenerator.NewID()
 
 		// Publish event
 		msg, err := json.Marshal(event)
@@ -154,10 +177,11 @@ func (m *txMonitorService) processBlock(ctx context.Context, block blockchain.Bl
 			m.logger.Error("Failed to marshal transaction event", "error", err)
 			continue
 		}
-		if err := m.publisher.Publish(ctx, pubsub.TopicTransaction, msg); err != nil {
+		if err := m.publisher.Publish(pubsub.WithEventID(ctx, event.EventID), pubsub.TopicTransaction, msg); err != nil {
 			m.logger.Error("Failed to publish transaction event",
 				"error", err,
 				"txHash", tx.Hash,
+				"eventID", event.EventID,
 			)
 		}
 
@@ -174,6 +198,49 @@ func (m *txMonitorService) processBlock(ctx context.Context, block blockchain.Bl
 	return nil
 }
 
+// enrichTransaction applies all configured enrichers to tx
+func (m *txMonitorService) enrichTransaction(ctx context.Context, tx *blockchain.Transaction) {
+	for _, enricher := range m.enrichers {
+		if err := enricher.Enrich(ctx, tx); err != nil {
+			m.logger.Warn("Failed to enrich transaction",
+				"error", err,
+				"txHash", tx.Hash,
+				"enricher", fmt.Sprintf("%T", enricher),
+			)
+		}
+	}
+}
+
+// newTransactionEvent builds the Kafka event published for a relevant transaction
+func newTransactionEvent(tx blockchain.Transaction) *pubsub.Transaction {
+	event := &pubsub.Transaction{
+		Source:         tx.Source,
+		Destination:    tx.Destination,
+		Amount:         tx.Amount,
+		Fees:           tx.Fees,
+		Hash:           tx.Hash,
+		Status:         string(tx.Status),
+		InputData:      tx.InputData,
+		MethodID:       tx.MethodID,
+		MethodName:     tx.MethodName,
+		SourceENS:      tx.SourceENS,
+		DestinationENS: tx.DestinationENS,
+		AmountUSD:      tx.AmountUSD,
+	}
+	if tx.Token != nil {
+		event.Token = &pubsub.TokenTransfer{
+			Contract:  tx.Token.Contract,
+			From:      tx.Token.From,
+			To:        tx.Token.To,
+			RawAmount: tx.Token.RawAmount,
+			Symbol:    tx.Token.Symbol,
+			Decimals:  tx.Token.Decimals,
+			Amount:    tx.Token.Amount,
+		}
+	}
+	return event
+}
+
 // isTransactionRelevant checks if the transaction involves watched addresses
 func (m *txMonitorService) isTransactionRelevant(ctx context.Context, tx blockchain.Transaction) bool {
 	return m.addressWatcher.IsWatched(ctx, tx.Source) || m.addressWatcher.IsWatched(ctx, tx.Destination)
@@ -308,3 +308,129 @@ func TestTxMonitorService_Start(t *testing.T) {
 	err = service.Stop(ctx)
 	assert.NoError(t, err, "Stop should not return an error")
 }
+
+func TestTxMonitorService_ProcessBlock_SkipFailedTransactions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockBlockchainClient := mocks.NewMockClient(ctrl)
+	mockAddressWatcher := mocks.NewMockWatcher(ctrl)
+	mockPublisher := mocks.NewMockPublisher(ctrl)
+	mockDlock := mocks.NewMockDistributedLock(ctrl)
+
+	service := NewTxMonitorService(logger, mockBlockchainClient, mockAddressWatcher, mockPublisher, mockDlock,
+		WithSkipFailedTransactions(true),
+	).(*txMonitorService)
+
+	ctx := context.Background()
+	blockHash := "block123"
+	sourceAddr := "0x1234"
+	destAddr := "0x5678"
+
+	// Prepare block with a failed and a successful relevant transaction
+	block := blockchain.Block{
+		Number: big.NewInt(100),
+		Hash:   blockHash,
+		Transactions: []blockchain.Transaction{
+			{
+				Source:      sourceAddr,
+				Destination: destAddr,
+				Amount:      big.NewInt(100),
+				Fees:        big.NewInt(10),
+				Hash:        "tx1hash",
+				Status:      blockchain.TransactionStatusFailed,
+			},
+			{
+				Source:      sourceAddr,
+				Destination: destAddr,
+				Amount:      big.NewInt(200),
+				Fees:        big.NewInt(20),
+				Hash:        "tx2hash",
+				Status:      blockchain.TransactionStatusSuccess,
+			},
+		},
+	}
+
+	lockKey := fmt.Sprintf("block_lock_%s", blockHash)
+	mockDlock.EXPECT().Lock(gomock.Any(), lockKey).Return(nil)
+	mockDlock.EXPECT().Unlock(gomock.Any(), lockKey).Return(true, nil)
+
+	mockAddressWatcher.EXPECT().IsWatched(gomock.Any(), sourceAddr).Return(false).Times(2)
+	mockAddressWatcher.EXPECT().IsWatched(gomock.Any(), destAddr).Return(true).Times(2)
+
+	// Only the successful transaction is published
+	expectedEvent := &pubsub.Transaction{
+		Source:      sourceAddr,
+		Destination: destAddr,
+		Amount:      big.NewInt(200),
+		Fees:        big.NewInt(20),
+		Hash:        "tx2hash",
+		Status:      "success",
+	}
+	expectedMsg, _ := json.Marshal(expectedEvent)
+	mockPublisher.EXPECT().Publish(gomock.Any(), pubsub.TopicTransaction, expectedMsg).Return(nil)
+
+	err := service.processBlock(ctx, block)
+	assert.NoError(t, err, "processBlock should not return an error")
+}
+
+func TestTxMonitorService_ProcessBlock_Enrichers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockBlockchainClient := mocks.NewMockClient(ctrl)
+	mockAddressWatcher := mocks.NewMockWatcher(ctrl)
+	mockPublisher := mocks.NewMockPublisher(ctrl)
+	mockDlock := mocks.NewMockDistributedLock(ctrl)
+	mockEnricher := mocks.NewMockEnricher(ctrl)
+
+	service := NewTxMonitorService(logger, mockBlockchainClient, mockAddressWatcher, mockPublisher, mockDlock,
+		WithEnrichers(mockEnricher),
+	).(*txMonitorService)
+
+	ctx := context.Background()
+	sourceAddr := "0x1234"
+	destAddr := "0x5678"
+	block := blockchain.Block{
+		Number: big.NewInt(100),
+		Hash:   "block123",
+		Transactions: []blockchain.Transaction{
+			{
+				Source:      sourceAddr,
+				Destination: destAddr,
+				Amount:      big.NewInt(100),
+				Fees:        big.NewInt(10),
+				Hash:        "tx1hash",
+			},
+		},
+	}
+
+	lockKey := fmt.Sprintf("block_lock_%s", block.Hash)
+	mockDlock.EXPECT().Lock(gomock.Any(), lockKey).Return(nil)
+	mockDlock.EXPECT().Unlock(gomock.Any(), lockKey).Return(true, nil)
+	mockAddressWatcher.EXPECT().IsWatched(gomock.Any(), sourceAddr).Return(true)
+
+	// Enrichment errors are logged but the enriched fields still get published
+	mockEnricher.EXPECT().
+		Enrich(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tx *blockchain.Transaction) error {
+			tx.DestinationENS = "merchant.eth"
+			return errors.New("source lookup failed")
+		})
+
+	expectedEvent := &pubsub.Transaction{
+		Source:         sourceAddr,
+		Destination:    destAddr,
+		Amount:         big.NewInt(100),
+		Fees:           big.NewInt(10),
+		Hash:           "tx1hash",
+		DestinationENS: "merchant.eth",
+	}
+	expectedMsg, _ := json.Marshal(expectedEvent)
+	mockPublisher.EXPECT().Publish(gomock.Any(), pubsub.TopicTransaction, expectedMsg).Return(nil)
+
+	err := service.processBlock(ctx, block)
+	assert.NoError(t, err, "processBlock should not return an error")
+}
@@ -86,6 +86,21 @@ func (mr *MockClientMockRecorder) GetTransactionReceipt(ctx, txHash any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionReceipt", reflect.TypeOf((*MockClient)(nil).GetTransactionReceipt), ctx, txHash)
 }
 
+// GetTransactionReceipts mocks base method.
+func (m *MockClient) GetTransactionReceipts(ctx context.Context, txHashes []string) ([]*blockchain.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionReceipts", ctx, txHashes)
+	ret0, _ := ret[0].([]*blockchain.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionReceipts indicates an expected call of GetTransactionReceipts.
+func (mr *MockClientMockRecorder) GetTransactionReceipts(ctx, txHashes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionReceipts", reflect.TypeOf((*MockClient)(nil).GetTransactionReceipts), ctx, txHashes)
+}
+
 // SubscribeToBlocks mocks base method.
 func (m *MockClient) SubscribeToBlocks(ctx context.Context) (<-chan blockchain.Block, <-chan error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: enrich.go
+//
+// Generated by this command:
+//
+//	mockgen -source=enrich.go -destination=../../mocks/mock_enricher.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	blockchain "deblock/internal/blockchain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEnricher is a mock of Enricher interface.
+type MockEnricher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnricherMockRecorder
+	isgomock struct{}
+}
+
+// MockEnricherMockRecorder is the mock recorder for MockEnricher.
+type MockEnricherMockRecorder struct {
+	mock *MockEnricher
+}
+
+// NewMockEnricher creates a new mock instance.
+func NewMockEnricher(ctrl *gomock.Controller) *MockEnricher {
+	mock := &MockEnricher{ctrl: ctrl}
+	mock.recorder = &MockEnricherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnricher) EXPECT() *MockEnricherMockRecorder {
+	return m.recorder
+}
+
+// Enrich mocks base method.
+func (m *MockEnricher) Enrich(ctx context.Context, tx *blockchain.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enrich", ctx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enrich indicates an expected call of Enrich.
+func (mr *MockEnricherMockRecorder) Enrich(ctx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enrich", reflect.TypeOf((*MockEnricher)(nil).Enrich), ctx, tx)
+}
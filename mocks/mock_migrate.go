@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: migrate.go
+//
+// Generated by this command:
+//
+//	mockgen -source=migrate.go -destination=../../mocks/mock_migrate.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVersionStore is a mock of VersionStore interface.
+type MockVersionStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockVersionStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockVersionStoreMockRecorder is the mock recorder for MockVersionStore.
+type MockVersionStoreMockRecorder struct {
+	mock *MockVersionStore
+}
+
+// NewMockVersionStore creates a new mock instance.
+func NewMockVersionStore(ctrl *gomock.Controller) *MockVersionStore {
+	mock := &MockVersionStore{ctrl: ctrl}
+	mock.recorder = &MockVersionStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVersionStore) EXPECT() *MockVersionStoreMockRecorder {
+	return m.recorder
+}
+
+// CurrentVersion mocks base method.
+func (m *MockVersionStore) CurrentVersion(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentVersion", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentVersion indicates an expected call of CurrentVersion.
+func (mr *MockVersionStoreMockRecorder) CurrentVersion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentVersion", reflect.TypeOf((*MockVersionStore)(nil).CurrentVersion), ctx)
+}
+
+// SetVersion mocks base method.
+func (m *MockVersionStore) SetVersion(ctx context.Context, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVersion", ctx, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVersion indicates an expected call of SetVersion.
+func (mr *MockVersionStoreMockRecorder) SetVersion(ctx, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVersion", reflect.TypeOf((*MockVersionStore)(nil).SetVersion), ctx, version)
+}
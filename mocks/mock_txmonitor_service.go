@@ -11,6 +11,7 @@ package mocks
 
 import (
 	context "context"
+	stats "deblock/internal/stats"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -40,6 +41,20 @@ func (m *MockTxMonitorService) EXPECT() *MockTxMonitorServiceMockRecorder {
 	return m.recorder
 }
 
+// CandidateStats mocks base method.
+func (m *MockTxMonitorService) CandidateStats(ctx context.Context) stats.CandidateStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CandidateStats", ctx)
+	ret0, _ := ret[0].(stats.CandidateStats)
+	return ret0
+}
+
+// CandidateStats indicates an expected call of CandidateStats.
+func (mr *MockTxMonitorServiceMockRecorder) CandidateStats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CandidateStats", reflect.TypeOf((*MockTxMonitorService)(nil).CandidateStats), ctx)
+}
+
 // IsRunning mocks base method.
 func (m *MockTxMonitorService) IsRunning(ctx context.Context) bool {
 	m.ctrl.T.Helper()